@@ -2,4 +2,12 @@ module github.com/neophenix/protoc-gen-validation
 
 go 1.12
 
-require github.com/gogo/protobuf v1.3.0
+require (
+	github.com/gogo/protobuf v1.3.0
+	github.com/golang/protobuf v1.3.2
+	github.com/google/cel-go v0.4.1
+	github.com/google/uuid v1.1.1
+	github.com/jhump/protoreflect v1.6.0
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55
+	google.golang.org/grpc v1.27.1
+)
@@ -0,0 +1,62 @@
+package grpcvalidator
+
+import (
+	"context"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+)
+
+// UnaryClientInterceptor calls Validate on every outgoing request that has one, failing the call with the error from
+// Validate, a *runtime.ValidationErrors for generated code, without ever sending it.  Unlike the status the server
+// would send back the whole error tree is there
+func UnaryClientInterceptor(opts ...ClientOption) grpc.UnaryClientInterceptor {
+	o := newClientOptions(opts)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		if err := o.validateOutgoing(method, req); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, callOpts...)
+	}
+}
+
+// StreamClientInterceptor calls Validate on every message sent on the stream, SendMsg returns the error from Validate
+// for anything invalid
+func StreamClientInterceptor(opts ...ClientOption) grpc.StreamClientInterceptor {
+	o := newClientOptions(opts)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, callOpts...)
+		if err != nil {
+			return nil, err
+		}
+		return &clientStream{ClientStream: cs, method: method, options: o}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	method  string
+	options *clientOptions
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	if err := s.options.validateOutgoing(s.method, m); err != nil {
+		return err
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+// validateOutgoing validates a message we are about to send, when we only log the error is swallowed
+func (o *clientOptions) validateOutgoing(method string, msg interface{}) error {
+	validator, ok := msg.(runtime.Validator)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate()
+	if err != nil && o.logOnly {
+		grpclog.Warningf("%s: request failed validation: %v", method, err)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package grpcvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	sent := false
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent = true
+		return nil
+	}
+
+	for name, test := range map[string]struct {
+		opts    []ClientOption
+		req     interface{}
+		invalid bool
+		sent    bool
+	}{
+		"valid":            {req: &message{ok: true}, sent: true},
+		"invalid":          {req: &message{}, invalid: true},
+		"without validate": {req: "request", sent: true},
+		"log only":         {opts: []ClientOption{WithLogOnly()}, req: &message{}, sent: true},
+	} {
+		sent = false
+		err := UnaryClientInterceptor(test.opts...)(context.Background(), "/test/Method", test.req, nil, nil, invoker)
+		// the client gets the errors from Validate as they are, not a status
+		if _, ok := err.(*runtime.ValidationErrors); ok != test.invalid {
+			t.Errorf("%s: expected ValidationErrors %v, got %v", name, test.invalid, err)
+		}
+		if sent != test.sent {
+			t.Errorf("%s: expected the request to be sent %v", name, test.sent)
+		}
+	}
+
+	if err := UnaryClientInterceptor()(context.Background(), "/test/Method", &otherMessage{}, nil, nil, invoker); err == nil || err.Error() != "not valid" {
+		t.Errorf("expected the error from Validate, got %v", err)
+	}
+}
+
+// testClientStream records what is sent
+type testClientStream struct {
+	grpc.ClientStream
+	sent []interface{}
+}
+
+func (s *testClientStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	stream := &testClientStream{}
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return stream, nil
+	}
+	cs, err := StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{}, nil, "/test/Method", streamer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.SendMsg(&message{ok: true}); err != nil {
+		t.Errorf("expected a valid message to be sent, got %v", err)
+	}
+	if _, ok := cs.SendMsg(&message{}).(*runtime.ValidationErrors); !ok {
+		t.Error("expected ValidationErrors for an invalid message")
+	}
+	if len(stream.sent) != 1 {
+		t.Errorf("expected only the valid message to be sent, sent %d", len(stream.sent))
+	}
+}
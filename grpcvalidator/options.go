@@ -0,0 +1,47 @@
+package grpcvalidator
+
+// ServerOption changes how the server interceptors behave
+type ServerOption func(*serverOptions)
+
+// ClientOption changes how the client interceptors behave
+type ClientOption func(*clientOptions)
+
+type serverOptions struct {
+	validateResponses bool
+}
+
+type clientOptions struct {
+	logOnly bool
+}
+
+// WithResponseValidation has the server interceptors also validate the messages the server sends back, failing the call
+// with codes.Internal if they don't pass.  Meant for debugging / testing since it is the server's fault, not the client's
+func WithResponseValidation() ServerOption {
+	return func(o *serverOptions) {
+		o.validateResponses = true
+	}
+}
+
+// WithLogOnly has the client interceptors log invalid requests with grpclog and send them anyway instead of failing the
+// call
+func WithLogOnly() ClientOption {
+	return func(o *clientOptions) {
+		o.logOnly = true
+	}
+}
+
+func newServerOptions(opts []ServerOption) *serverOptions {
+	o := &serverOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,87 @@
+package grpcvalidator
+
+import (
+	"context"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor calls Validate on every request that has one, rejecting invalid requests with InvalidArgument
+// before the handler is called
+func UnaryServerInterceptor(opts ...ServerOption) grpc.UnaryServerInterceptor {
+	o := newServerOptions(opts)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateRequest(req); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if err == nil && o.validateResponses {
+			if verr := validateResponse(resp); verr != nil {
+				return nil, verr
+			}
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor calls Validate on every message received on the stream, RecvMsg returns InvalidArgument for
+// anything invalid
+func StreamServerInterceptor(opts ...ServerOption) grpc.StreamServerInterceptor {
+	o := newServerOptions(opts)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, options: o})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	options *serverOptions
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	if s.options.validateResponses {
+		if err := validateResponse(m); err != nil {
+			return err
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// validateRequest returns an InvalidArgument status error if msg has a Validate and fails it
+func validateRequest(msg interface{}) error {
+	validator, ok := msg.(runtime.Validator)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate()
+	if err == nil {
+		return nil
+	}
+	if verr, ok := err.(*runtime.ValidationErrors); ok {
+		return Status(verr).Err()
+	}
+	// a Validate we didn't generate
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// validateResponse returns an Internal status error if msg has a Validate and fails it
+func validateResponse(msg interface{}) error {
+	validator, ok := msg.(runtime.Validator)
+	if !ok {
+		return nil
+	}
+	if err := validator.Validate(); err != nil {
+		return status.Errorf(codes.Internal, "response failed validation: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package grpcvalidator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// message stands in for a generated message, it is valid when ok is set
+type message struct {
+	ok bool
+}
+
+func (m *message) Validate() error {
+	if m.ok {
+		return nil
+	}
+	return &runtime.ValidationErrors{Errors: []*runtime.ValidationError{{Field: "name", Path: "name", ErrorMessage: "name is required"}}}
+}
+
+// otherMessage has a Validate we didn't generate
+type otherMessage struct{}
+
+func (m *otherMessage) Validate() error {
+	return errors.New("not valid")
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &message{}, nil
+	}
+	interceptor := UnaryServerInterceptor()
+
+	for name, test := range map[string]struct {
+		req    interface{}
+		code   codes.Code
+		called bool
+	}{
+		"valid":            {req: &message{ok: true}, code: codes.OK, called: true},
+		"invalid":          {req: &message{}, code: codes.InvalidArgument},
+		"other validate":   {req: &otherMessage{}, code: codes.InvalidArgument},
+		"without validate": {req: "request", code: codes.OK, called: true},
+	} {
+		called = false
+		_, err := interceptor(context.Background(), test.req, &grpc.UnaryServerInfo{}, handler)
+		if status.Code(err) != test.code {
+			t.Errorf("%s: expected %s, got %v", name, test.code, err)
+		}
+		if called != test.called {
+			t.Errorf("%s: expected the handler to be called %v", name, test.called)
+		}
+	}
+
+	// the invalid response is only caught when asked for
+	if _, err := UnaryServerInterceptor(WithResponseValidation())(context.Background(), &message{ok: true}, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal for an invalid response, got %v", err)
+	}
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if _, err := UnaryServerInterceptor(WithResponseValidation())(context.Background(), &message{ok: true}, &grpc.UnaryServerInfo{}, failing); status.Code(err) != codes.NotFound {
+		t.Errorf("expected the handler's error, got %v", err)
+	}
+}
+
+// testServerStream receives recv and records what is sent
+type testServerStream struct {
+	grpc.ServerStream
+	recv interface{}
+	sent []interface{}
+}
+
+func (s *testServerStream) RecvMsg(m interface{}) error {
+	*(m.(*message)) = *(s.recv.(*message))
+	return nil
+}
+
+func (s *testServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	for name, test := range map[string]struct {
+		opts     []ServerOption
+		recv     *message
+		send     *message
+		recvCode codes.Code
+		sendCode codes.Code
+	}{
+		"valid":            {recv: &message{ok: true}, send: &message{}, recvCode: codes.OK, sendCode: codes.OK},
+		"invalid":          {recv: &message{}, send: &message{}, recvCode: codes.InvalidArgument, sendCode: codes.OK},
+		"invalid response": {opts: []ServerOption{WithResponseValidation()}, recv: &message{ok: true}, send: &message{}, recvCode: codes.OK, sendCode: codes.Internal},
+	} {
+		stream := &testServerStream{recv: test.recv}
+		handler := func(srv interface{}, ss grpc.ServerStream) error {
+			if err := ss.RecvMsg(&message{}); status.Code(err) != test.recvCode {
+				t.Errorf("%s: expected RecvMsg to give %s, got %v", name, test.recvCode, err)
+			}
+			if err := ss.SendMsg(test.send); status.Code(err) != test.sendCode {
+				t.Errorf("%s: expected SendMsg to give %s, got %v", name, test.sendCode, err)
+			}
+			return nil
+		}
+		if err := StreamServerInterceptor(test.opts...)(nil, stream, &grpc.StreamServerInfo{}, handler); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if want := test.sendCode == codes.OK; (len(stream.sent) == 1) != want {
+			t.Errorf("%s: expected the response to be sent %v, sent %d", name, want, len(stream.sent))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package grpcvalidator
+
+import (
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Status turns the errors from Validate into an InvalidArgument status with a google.rpc.BadRequest detail that has a
+// FieldViolation for every error that doesn't have nested errors of its own, using the full path as the field.  A nil
+// verr gives a plain InvalidArgument status without details
+func Status(verr *runtime.ValidationErrors) *status.Status {
+	st := status.New(codes.InvalidArgument, verr.Error())
+	if verr == nil {
+		return st
+	}
+	br := &errdetails.BadRequest{}
+	errors := verr.Errors
+	for i := 0; i < len(errors); i++ {
+		if len(errors[i].Errors) != 0 {
+			errors = append(errors, errors[i].Errors...)
+			continue
+		}
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       errors[i].Path,
+			Description: errors[i].ErrorMessage,
+		})
+	}
+	if withDetails, err := st.WithDetails(br); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// Error is a shortcut for returning from a handler, if err came from Validate it is converted with Status, anything
+// else is returned as is
+func Error(err error) error {
+	if verr, ok := err.(*runtime.ValidationErrors); ok {
+		return Status(verr).Err()
+	}
+	return err
+}
+
+// FromError is the reverse of Status for clients, it builds ValidationErrors from the BadRequest details of a status
+// error.  The tree isn't rebuilt, each violation becomes a top level ValidationError with both Field and Path set to the
+// field of the violation, which is the full path, the same as any other top level error.  ok is false if err isn't an InvalidArgument status with BadRequest details
+func FromError(err error) (*runtime.ValidationErrors, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return nil, false
+	}
+	verr := &runtime.ValidationErrors{Errors: []*runtime.ValidationError{}}
+	found := false
+	for _, detail := range st.Details() {
+		br, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		found = true
+		for _, fv := range br.FieldViolations {
+			verr.Errors = append(verr.Errors, &runtime.ValidationError{
+				Field:        fv.Field,
+				Path:         fv.Field,
+				ErrorMessage: fv.Description,
+			})
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return verr, true
+}
@@ -0,0 +1,91 @@
+package grpcvalidator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testErrors() *runtime.ValidationErrors {
+	return &runtime.ValidationErrors{Errors: []*runtime.ValidationError{
+		{Field: "name", Path: "name", ErrorMessage: "name is required"},
+		{Field: "inner", Path: "inner", ErrorMessage: "error in inner", Errors: []*runtime.ValidationError{
+			{Field: "labels[a.b]", Path: "inner.labels[a.b]", ErrorMessage: "labels[a.b] is too long"},
+		}},
+		{Field: "count", Path: "count", ErrorMessage: "count must be greater than 1"},
+	}}
+}
+
+func TestStatus(t *testing.T) {
+	st := Status(testErrors())
+	if st.Code() != codes.InvalidArgument || st.Message() != "name is required" {
+		t.Fatalf("expected InvalidArgument with the first message, got %s %q", st.Code(), st.Message())
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("expected one detail, got %v", st.Details())
+	}
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatalf("expected a BadRequest, got %T", st.Details()[0])
+	}
+	// only errors without nested errors, breadth first
+	want := []*errdetails.BadRequest_FieldViolation{
+		{Field: "name", Description: "name is required"},
+		{Field: "count", Description: "count must be greater than 1"},
+		{Field: "inner.labels[a.b]", Description: "labels[a.b] is too long"},
+	}
+	if !reflect.DeepEqual(br.FieldViolations, want) {
+		t.Errorf("expected violations %v, got %v", want, br.FieldViolations)
+	}
+}
+
+func TestStatusNil(t *testing.T) {
+	st := Status(nil)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %s", st.Code())
+	}
+	if len(st.Details()) != 0 {
+		t.Errorf("expected no details, got %v", st.Details())
+	}
+}
+
+func TestError(t *testing.T) {
+	if st, _ := status.FromError(Error(testErrors())); st.Code() != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %s", st.Code())
+	}
+	other := errors.New("other")
+	if err := Error(other); err != other {
+		t.Errorf("expected other errors to be returned as is, got %v", err)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	verr, ok := FromError(Status(testErrors()).Err())
+	if !ok {
+		t.Fatal("expected the errors back")
+	}
+	want := []*runtime.ValidationError{
+		{Field: "name", Path: "name", ErrorMessage: "name is required"},
+		{Field: "count", Path: "count", ErrorMessage: "count must be greater than 1"},
+		{Field: "inner.labels[a.b]", Path: "inner.labels[a.b]", ErrorMessage: "labels[a.b] is too long"},
+	}
+	if !reflect.DeepEqual(verr.Errors, want) {
+		t.Errorf("expected %v, got %v", want, verr.Errors)
+	}
+
+	for name, err := range map[string]error{
+		"not a status":      errors.New("other"),
+		"wrong code":        status.Error(codes.Internal, "internal"),
+		"without a request": status.Error(codes.InvalidArgument, "invalid"),
+		"nil":               Status(nil).Err(),
+	} {
+		if _, ok := FromError(err); ok {
+			t.Errorf("%s: expected not ok", name)
+		}
+	}
+}
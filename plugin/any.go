@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// fieldValue is the pointer to the Any itself, we only ever look at TypeUrl / Value so we don't need its go type
+func (p *Plugin) generateAnyValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if len(v.AnyIn) != 0 {
+		p.P(`switch %s.TypeUrl {`, fieldValue)
+		p.P(`case %s:`, quoteStrings(v.AnyIn))
+		p.P(`default:`)
+		p.generateErrorCode(fieldName, "any.in", strings.Join(v.AnyIn, ", "), fieldValue+".TypeUrl", "{field} must be one of {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if len(v.AnyNotIn) != 0 {
+		p.P(`switch %s.TypeUrl {`, fieldValue)
+		p.P(`case %s:`, quoteStrings(v.AnyNotIn))
+		p.generateErrorCode(fieldName, "any.not_in", strings.Join(v.AnyNotIn, ", "), fieldValue+".TypeUrl", "{field} must not be one of {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.GetAnyValidate() {
+		p.P(`if anymsg, unpackerr := %s.UnpackAny(%s.TypeUrl, %s.Value); unpackerr != nil {`, p.runtimePkg.Use(), fieldValue, fieldValue)
+		p.generateErrorCode(fieldName, "any.unpack", "", fieldValue+".TypeUrl", "{field} could not be unpacked", v, mv, field, "")
+		p.P(`} else if validator, ok := anymsg.(%s.Validator); ok {`, p.runtimePkg.Use())
+		p.P("msgerr := validator.Validate()")
+		p.P("if msgerr != nil {")
+		p.P("if msgvalerr, ok := msgerr.(*%s.ValidationErrors); ok {", p.runtimePkg.Use())
+		p.generateErrorCode(fieldName, "message.nested", "", "", "error in {field}", v, mv, field, "msgvalerr")
+		p.P("}")
+		p.P("}")
+		p.P("}")
+	}
+}
+
+// quoteStrings makes the list of go string literals for a case
+func quoteStrings(values []string) string {
+	quoted := []string{}
+	for _, val := range uniqueStrings(values) {
+		quoted = append(quoted, fmt.Sprintf("%q", val))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func isAny(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == wktBasePath+"Any"
+}
@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestAny(t *testing.T) {
+	testGenerated(t, "any")
+}
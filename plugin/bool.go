@@ -0,0 +1,26 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+func (p *Plugin) generateBoolValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if v.BoolConst != nil {
+		p.P(`if %s != %t {`, fieldValue, v.GetBoolConst())
+		p.generateErrorCode(fieldName, "bool.const", fmt.Sprintf("%t", v.GetBoolConst()), fieldValue, "{field} must be {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+}
+
+func isBool(field *descriptor.FieldDescriptorProto) bool {
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_BOOL {
+		return true
+	}
+	if isWKTBool(field.GetTypeName()) {
+		return true
+	}
+	return false
+}
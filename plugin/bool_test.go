@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestBools(t *testing.T) {
+	testGenerated(t, "bools")
+}
@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// byte values can be anything, so they are quoted as go strings in the code and shown as hex in error messages
+func (p *Plugin) generateBytesValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if v.BytesMinLen != nil {
+		p.P(`if len(%s) < %d {`, fieldValue, v.GetBytesMinLen())
+		p.generateErrorCode(fieldName, "bytes.min_len", fmt.Sprintf("%d", v.GetBytesMinLen()), fieldValue, "{field} must be at least {value} bytes", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesMaxLen != nil {
+		p.P(`if len(%s) > %d {`, fieldValue, v.GetBytesMaxLen())
+		p.generateErrorCode(fieldName, "bytes.max_len", fmt.Sprintf("%d", v.GetBytesMaxLen()), fieldValue, "{field} must be no more than {value} bytes", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesEqLen != nil {
+		p.P(`if len(%s) != %d {`, fieldValue, v.GetBytesEqLen())
+		p.generateErrorCode(fieldName, "bytes.eq_len", fmt.Sprintf("%d", v.GetBytesEqLen()), fieldValue, "{field} must be exactly {value} bytes", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesPrefix != nil {
+		p.P(`if !%s.HasPrefix(%s, []byte(%q)) {`, p.bytesPkg.Use(), fieldValue, v.GetBytesPrefix())
+		p.generateErrorCode(fieldName, "bytes.prefix", fmt.Sprintf("0x%x", v.GetBytesPrefix()), fieldValue, "{field} must start with {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesSuffix != nil {
+		p.P(`if !%s.HasSuffix(%s, []byte(%q)) {`, p.bytesPkg.Use(), fieldValue, v.GetBytesSuffix())
+		p.generateErrorCode(fieldName, "bytes.suffix", fmt.Sprintf("0x%x", v.GetBytesSuffix()), fieldValue, "{field} must end with {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesContains != nil {
+		p.P(`if !%s.Contains(%s, []byte(%q)) {`, p.bytesPkg.Use(), fieldValue, v.GetBytesContains())
+		p.generateErrorCode(fieldName, "bytes.contains", fmt.Sprintf("0x%x", v.GetBytesContains()), fieldValue, "{field} must contain {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.BytesRegex != nil {
+		p.P(`if !%s.Match(%s) {`, p.regexVar("field "+fieldName, v.GetBytesRegex()), fieldValue)
+		p.generateErrorCode(fieldName, "bytes.regex", v.GetBytesRegex(), fieldValue, "{field} must match regex {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+}
+
+func isBytes(field *descriptor.FieldDescriptorProto) bool {
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_BYTES {
+		return true
+	}
+	if isWKTBytes(field.GetTypeName()) {
+		return true
+	}
+	return false
+}
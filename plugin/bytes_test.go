@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestBytes(t *testing.T) {
+	testGenerated(t, "bytes")
+}
@@ -0,0 +1,929 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	golangproto "github.com/golang/protobuf/proto"
+	golangdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	pb "github.com/neophenix/protoc-gen-validation"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// cel expressions are parsed and type checked by cel-go when the code is generated, then turned into plain go so the
+// generated code doesn't need cel at all.  Not everything cel can do has a go translation, anything we can't handle
+// fails generation the same as a type error would
+
+// generateCELValidationCode runs the cel rules, they go after everything else in Validate.  Field rules come first in
+// the order of the fields, then the message rules
+func (p *Plugin) generateCELValidationCode(message *generator.Descriptor, mv *pb.MessageValidation) {
+	for _, field := range message.Field {
+		v := getFieldValidation(field)
+		if v == nil || v.DoNotValidate != nil || len(v.Cel) == 0 {
+			continue
+		}
+		// a oneof field is only checked when it is the case that is set, a proto2 field that isn't set shows the default
+		// value cel saw, which takes a variable that the block keeps to this field
+		actual := "m." + generator.CamelCase(field.GetName())
+		if field.OneofIndex != nil {
+			p.P("if o, ok := m.%s.(*%s); ok {", generator.CamelCase(message.OneofDecl[field.GetOneofIndex()].GetName()), oneofTypeName(message, field))
+			actual = "o." + generator.CamelCase(field.GetName())
+		} else if p.isPointerScalar(field) {
+			p.P("{")
+			actual = p.scalarValue(message, field, "actual")
+		}
+		for _, rule := range v.Cel {
+			where := fmt.Sprintf("field %s: cel expression %q", field.GetName(), rule.GetExpression())
+			t := &celTranslator{p: p, file: message.File(), where: where}
+			this := t.fieldValue(celValue{expr: "m"}, message, field)
+			t.vars = map[string]celValue{"this": this}
+			t.checked = p.compileCEL(where, message.File().GetPackage(), p.celFieldType(field), rule.GetExpression())
+			p.generateCELRuleCode(t, rule, field.GetName(), jsonName(field), actual, "{field} must satisfy {value}", mv)
+		}
+		if field.OneofIndex != nil || p.isPointerScalar(field) {
+			p.P("}")
+		}
+	}
+
+	name := strings.TrimPrefix(celMessageName(message), ".")
+	for _, rule := range mv.GetCel() {
+		where := fmt.Sprintf("message %s: cel expression %q", message.GetName(), rule.GetExpression())
+		t := &celTranslator{p: p, file: message.File(), where: where, vars: map[string]celValue{"this": {expr: "m"}}}
+		t.checked = p.compileCEL(where, message.File().GetPackage(), decls.NewObjectType(name), rule.GetExpression())
+		// errors about the message as a whole use "message" as the field, the same as a nil message does
+		p.generateCELRuleCode(t, rule, "message", "message", "", "{field} must satisfy {value}", mv)
+	}
+}
+
+// celMessageName is the full name of the message with the leading dot, the way field type names refer to it
+func celMessageName(message *generator.Descriptor) string {
+	name := "." + strings.Join(message.TypeName(), ".")
+	if message.File().GetPackage() != "" {
+		name = "." + message.File().GetPackage() + name
+	}
+	return name
+}
+
+func (p *Plugin) generateCELRuleCode(t *celTranslator, rule *pb.CelValidation, fieldName string, jsonName string, actual string, errorMsg string, mv *pb.MessageValidation) {
+	ruleName := "cel"
+	if rule.GetId() != "" {
+		ruleName = "cel." + rule.GetId()
+	}
+	if rule.GetMessage() != "" {
+		errorMsg = rule.GetMessage()
+	}
+
+	p.P("if ok, celerr := %s.EvalCEL(func() bool {", p.runtimePkg.Use())
+	p.P("return %s", t.translate(t.checked.Expr).expr)
+	p.P("}); !ok || celerr != nil {")
+	p.generateFieldErrorCode(fieldName, jsonName, "", ruleName, rule.GetExpression(), actual, errorMsg, nil, mv, "")
+	p.P("}")
+}
+
+// celBaseEnv knows about every message protoc gave us, it is only built the first time a file uses cel
+func (p *Plugin) celBaseEnv() *cel.Env {
+	if p.celEnv != nil {
+		return p.celEnv
+	}
+	// cel-go wants github.com/golang/protobuf descriptors, the wire format is the same so we can convert through it
+	registry := types.NewRegistry()
+	for _, file := range p.gen.AllFiles().File {
+		b, err := proto.Marshal(file)
+		if err != nil {
+			p.gen.Error(err, "marshalling", file.GetName(), "for cel")
+		}
+		fd := &golangdescriptor.FileDescriptorProto{}
+		if err := golangproto.Unmarshal(b, fd); err != nil {
+			p.gen.Error(err, "unmarshalling", file.GetName(), "for cel")
+		}
+		if err := registry.RegisterDescriptor(fd); err != nil {
+			p.gen.Error(err, "registering", file.GetName(), "for cel")
+		}
+	}
+	env, err := cel.NewEnv(cel.CustomTypeProvider(&celTypeProvider{TypeProvider: registry, p: p}))
+	if err != nil {
+		p.gen.Error(err, "creating cel environment")
+	}
+	p.celEnv = env
+	return env
+}
+
+// celTypeProvider gives the checker field types from our own descriptors.  cel-go's registry works them out with
+// reflection on the go types, which don't exist inside the plugin, so without this a map looks like a list of entries
+type celTypeProvider struct {
+	ref.TypeProvider
+	p *Plugin
+}
+
+func (tp *celTypeProvider) FindFieldType(messageType string, fieldName string) (*ref.FieldType, bool) {
+	typeName := "." + messageType
+	if isWKT(typeName) || !tp.p.messageExists(typeName) {
+		return tp.TypeProvider.FindFieldType(messageType, fieldName)
+	}
+	msg := tp.p.gen.ObjectNamed(typeName).(*generator.Descriptor)
+	for _, field := range msg.Field {
+		if field.GetName() == fieldName {
+			return &ref.FieldType{Type: tp.p.celFieldType(field), SupportsPresence: !field.IsRepeated()}, true
+		}
+	}
+	return nil, false
+}
+
+// compileCEL parses and type checks expression with this declared as the given type, names are resolved relative to
+// the package of the file
+func (p *Plugin) compileCEL(where string, pkg string, this *exprpb.Type, expression string) *exprpb.CheckedExpr {
+	env, err := p.celBaseEnv().Extend(cel.Container(pkg), cel.Declarations(decls.NewIdent("this", this, nil)))
+	if err != nil {
+		p.gen.Fail(fmt.Sprintf("%s: %s", where, err))
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		p.gen.Fail(fmt.Sprintf("%s: %s", where, issues.Err()))
+	}
+	if ast.ResultType().GetPrimitive() != exprpb.Type_BOOL {
+		p.gen.Fail(fmt.Sprintf("%s: must return a bool", where))
+	}
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		p.gen.Fail(fmt.Sprintf("%s: %s", where, err))
+	}
+	return checked
+}
+
+// celFieldType is the cel type of a field, types we don't support are dyn so they only fail when an expression uses them
+func (p *Plugin) celFieldType(field *descriptor.FieldDescriptorProto) *exprpb.Type {
+	if p.gen.IsMap(field) {
+		entry := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+		return decls.NewMapType(p.celSingularType(entry.Field[0]), p.celSingularType(entry.Field[1]))
+	}
+	if field.IsRepeated() {
+		return decls.NewListType(p.celSingularType(field))
+	}
+	return p.celSingularType(field)
+}
+
+func (p *Plugin) celSingularType(field *descriptor.FieldDescriptorProto) *exprpb.Type {
+	typeName := field.GetTypeName()
+	switch {
+	case isWKTWrapper(typeName):
+		return decls.NewWrapperType(celWrappedType(typeName))
+	case isTimestamp(field):
+		return decls.Timestamp
+	case isDuration(field):
+		return decls.Duration
+	case field.IsMessage() && isWKT(typeName):
+		return decls.Dyn
+	case field.IsMessage():
+		return decls.NewObjectType(strings.TrimPrefix(typeName, "."))
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return decls.Uint
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return decls.Double
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return decls.String
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return decls.Bool
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return decls.Bytes
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return decls.Dyn
+	}
+	// every int type and enums
+	return decls.Int
+}
+
+func celWrappedType(typeName string) *exprpb.Type {
+	switch strings.TrimPrefix(typeName, wktBasePath) {
+	case "UInt32Value", "UInt64Value":
+		return decls.Uint
+	case "FloatValue", "DoubleValue":
+		return decls.Double
+	case "StringValue":
+		return decls.String
+	case "BoolValue":
+		return decls.Bool
+	case "BytesValue":
+		return decls.Bytes
+	}
+	return decls.Int
+}
+
+// celTranslator turns a checked cel expression into a go expression.  Values are always converted to one go type per
+// cel type (int64, uint64, float64, string, bool, []byte, time.Time, time.Duration or a message pointer) so the
+// operators only have to deal with those
+type celTranslator struct {
+	p       *Plugin
+	file    *generator.FileDescriptor
+	where   string
+	checked *exprpb.CheckedExpr
+	vars    map[string]celValue
+}
+
+// celValue is a translated expression.  field is set when expr is still the go value of a repeated / map field, so
+// its items can be converted when they are used, and null is set for a message, it is a go condition that is true when
+// the message is null
+type celValue struct {
+	expr  string
+	field *descriptor.FieldDescriptorProto
+	null  string
+}
+
+func (t *celTranslator) fail(format string, args ...interface{}) {
+	t.p.gen.Fail(fmt.Sprintf("%s: %s", t.where, fmt.Sprintf(format, args...)))
+}
+
+func (t *celTranslator) typeOf(e *exprpb.Expr) *exprpb.Type {
+	return t.checked.TypeMap[e.Id]
+}
+
+func (t *celTranslator) translate(e *exprpb.Expr) celValue {
+	// enum values and other constants the checker resolved for us
+	if ref, ok := t.checked.ReferenceMap[e.Id]; ok && ref.Value != nil {
+		return celValue{expr: t.constant(ref.Value)}
+	}
+
+	switch kind := e.ExprKind.(type) {
+	case *exprpb.Expr_ConstExpr:
+		return celValue{expr: t.constant(kind.ConstExpr)}
+	case *exprpb.Expr_IdentExpr:
+		name := kind.IdentExpr.Name
+		if ref, ok := t.checked.ReferenceMap[e.Id]; ok && ref.Name != "" {
+			name = ref.Name
+		}
+		v, ok := t.vars[name]
+		if !ok {
+			t.fail("unknown identifier %s", name)
+		}
+		return v
+	case *exprpb.Expr_SelectExpr:
+		return t.selectField(kind.SelectExpr)
+	case *exprpb.Expr_CallExpr:
+		return t.call(e, kind.CallExpr)
+	case *exprpb.Expr_ListExpr:
+		return t.list(e, kind.ListExpr)
+	case *exprpb.Expr_ComprehensionExpr:
+		return t.comprehension(e, kind.ComprehensionExpr)
+	}
+	t.fail("message literals are not supported")
+	return celValue{}
+}
+
+func (t *celTranslator) constant(c *exprpb.Constant) string {
+	switch kind := c.ConstantKind.(type) {
+	case *exprpb.Constant_Int64Value:
+		return fmt.Sprintf("int64(%d)", kind.Int64Value)
+	case *exprpb.Constant_Uint64Value:
+		return fmt.Sprintf("uint64(%d)", kind.Uint64Value)
+	case *exprpb.Constant_DoubleValue:
+		return fmt.Sprintf("float64(%s)", strconv.FormatFloat(kind.DoubleValue, 'g', -1, 64))
+	case *exprpb.Constant_StringValue:
+		return strconv.Quote(kind.StringValue)
+	case *exprpb.Constant_BytesValue:
+		return fmt.Sprintf("[]byte(%q)", string(kind.BytesValue))
+	case *exprpb.Constant_BoolValue:
+		return strconv.FormatBool(kind.BoolValue)
+	case *exprpb.Constant_NullValue:
+		t.fail("null can only be compared to a message field")
+	}
+	t.fail("unsupported constant %v", c)
+	return ""
+}
+
+func (t *celTranslator) selectField(sel *exprpb.Expr_Select) celValue {
+	operandType := t.typeOf(sel.Operand)
+	if operandType.GetMapType() != nil {
+		// map.key is the same as map["key"]
+		return t.index(t.translate(sel.Operand), celValue{expr: strconv.Quote(sel.Field)}, operandType)
+	}
+
+	operand := t.translate(sel.Operand)
+	msg := t.message(operandType)
+	var field *descriptor.FieldDescriptorProto
+	for _, f := range msg.Field {
+		if f.GetName() == sel.Field {
+			field = f
+		}
+	}
+	if field == nil {
+		t.fail("%s has no field %s", msg.GetName(), sel.Field)
+	}
+	if sel.TestOnly {
+		return celValue{expr: t.has(operand, msg, field)}
+	}
+	return t.fieldValue(operand, msg, field)
+}
+
+func (t *celTranslator) message(typ *exprpb.Type) *generator.Descriptor {
+	if typ.GetMessageType() == "" {
+		t.fail("fields can only be selected from messages and maps")
+	}
+	msg, ok := t.p.gen.ObjectNamed("." + typ.GetMessageType()).(*generator.Descriptor)
+	if !ok {
+		t.fail("%s is not a message", typ.GetMessageType())
+	}
+	return msg
+}
+
+// fieldValue reads the field straight from the struct, gogo can be told not to generate getters so we can't use them.
+// Like in cel a message that isn't set reads as all defaults, and so does a proto2 field or oneof case that isn't set.
+// operand is only nil checked when it can be nil, m never is
+func (t *celTranslator) fieldValue(operand celValue, msg *generator.Descriptor, field *descriptor.FieldDescriptorProto) celValue {
+	structExpr := operand.expr
+	if operand.null != "" {
+		structExpr = "msg"
+	}
+	access := structExpr + "." + generator.CamelCase(field.GetName())
+	check := ""
+	switch {
+	case field.OneofIndex != nil:
+		oneof := generator.CamelCase(msg.OneofDecl[field.GetOneofIndex()].GetName())
+		check = fmt.Sprintf("if oneof, ok := %s.%s.(*%s); ok {", structExpr, oneof, t.oneofWrapper(msg, field))
+		access = "oneof." + generator.CamelCase(field.GetName())
+	case pointerScalar(msg.File().GetSyntax() == "proto3", field):
+		check = fmt.Sprintf("if %s != nil {", access)
+		access = "*" + access
+	}
+
+	if check == "" && operand.null == "" {
+		// nothing on the way to the field can be unset, so it is used as is
+		if field.IsRepeated() {
+			return celValue{expr: access, field: field}
+		}
+		v := celValue{expr: t.convert(access, field)}
+		if field.IsMessage() {
+			v.null = access + " == nil"
+		}
+		return v
+	}
+	if field.IsRepeated() {
+		return celValue{expr: t.guard(operand, check, access, t.goFieldType(msg, field), "nil"), field: field}
+	}
+	goType, def := t.fieldDefault(msg, field)
+	v := celValue{expr: t.guard(operand, check, t.convert(access, field), goType, def)}
+	if field.IsMessage() {
+		v.null = t.guard(operand, check, access+" == nil", "bool", "true")
+	}
+	return v
+}
+
+// guard wraps value in a func that gives def instead when operand is nil or the field's check, which opens an if, fails
+func (t *celTranslator) guard(operand celValue, check string, value string, goType string, def string) string {
+	body := "return " + value
+	if check != "" {
+		body = fmt.Sprintf("%s\n%s\n}", check, body)
+	}
+	if operand.null != "" {
+		body = fmt.Sprintf("if msg := %s; msg != nil {\n%s\n}", operand.expr, body)
+	}
+	return fmt.Sprintf("func() %s {\n%s\nreturn %s\n}()", goType, body, def)
+}
+
+// goFieldType is the go type of the field in the struct, it only works for fields that are generated as-is
+func (t *celTranslator) goFieldType(msg *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
+	if t.p.gen.IsMap(field) {
+		return t.p.gen.GoMapType(nil, field).GoType
+	}
+	goType, _ := t.p.gen.GoType(msg, field)
+	if field.IsMessage() || field.IsEnum() {
+		t.p.gen.RecordTypeUse(field.GetTypeName())
+	}
+	return goType
+}
+
+// fieldDefault is the go type fieldValue gives for a singular field and the value it has when it isn't set
+func (t *celTranslator) fieldDefault(msg *generator.Descriptor, field *descriptor.FieldDescriptorProto) (string, string) {
+	if field.IsMessage() && !isWKT(field.GetTypeName()) {
+		return t.goFieldType(msg, field), "nil"
+	}
+
+	goType := t.goType(t.p.celFieldType(field))
+	switch {
+	case field.DefaultValue != nil:
+		// proto2 defaults are generated as constants next to the message
+		t.p.gen.RecordTypeUse(celMessageName(msg))
+		constant := fmt.Sprintf("%sDefault_%s_%s", t.p.gen.DefaultPackageName(msg), generator.CamelCaseSlice(msg.TypeName()), generator.CamelCase(field.GetName()))
+		return goType, t.convert(constant, field)
+	case field.IsEnum():
+		// which is the first value for a proto2 enum
+		enum := t.p.gen.ObjectNamed(field.GetTypeName()).(*generator.EnumDescriptor)
+		return goType, fmt.Sprintf("int64(%d)", enum.Value[0].GetNumber())
+	case isTimestamp(field):
+		return goType, t.p.timePkg.Use() + ".Unix(0, 0)"
+	}
+	switch goType {
+	case "string":
+		return goType, `""`
+	case "bool":
+		return goType, "false"
+	case "[]byte":
+		return goType, "nil"
+	}
+	return goType, "0"
+}
+
+// oneofWrapper is the go type holding the field when it is the case of its oneof that is set
+func (t *celTranslator) oneofWrapper(msg *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
+	t.p.gen.RecordTypeUse(celMessageName(msg))
+	return t.p.gen.DefaultPackageName(msg) + oneofTypeName(msg, field)
+}
+
+// convert turns a single go value of the field's type into the go type we use for its cel type
+func (t *celTranslator) convert(expr string, field *descriptor.FieldDescriptorProto) string {
+	typeName := field.GetTypeName()
+	switch {
+	case isWKTWrapper(typeName):
+		value := expr + ".GetValue()"
+		switch strings.TrimPrefix(typeName, wktBasePath) {
+		case "Int32Value":
+			return "int64(" + value + ")"
+		case "UInt32Value":
+			return "uint64(" + value + ")"
+		case "FloatValue":
+			return "float64(" + value + ")"
+		}
+		return value
+	case isTimestamp(field):
+		return fmt.Sprintf("%s.Unix(%s.GetSeconds(), int64(%s.GetNanos()))", t.p.timePkg.Use(), expr, expr)
+	case isDuration(field):
+		return fmt.Sprintf("(%s.Duration(%s.GetSeconds())*%s.Second + %s.Duration(%s.GetNanos()))", t.p.timePkg.Use(), expr, t.p.timePkg.Use(), t.p.timePkg.Use(), expr)
+	case field.IsMessage() && isWKT(typeName):
+		t.fail("%s fields are not supported", strings.TrimPrefix(typeName, wktBasePath))
+	case field.IsMessage():
+		return expr
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return "int64(" + expr + ")"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return "uint64(" + expr + ")"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return "float64(" + expr + ")"
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		t.fail("groups are not supported")
+	}
+	return expr
+}
+
+// has works on any field, for the ones without presence it checks for a non empty / zero value
+func (t *celTranslator) has(operand celValue, msg *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
+	structExpr := operand.expr
+	if operand.null != "" {
+		structExpr = "msg"
+	}
+	access := structExpr + "." + generator.CamelCase(field.GetName())
+	proto3 := msg.File().GetSyntax() == "proto3"
+
+	var set string
+	switch {
+	case field.IsRepeated():
+		set = fmt.Sprintf("len(%s) != 0", access)
+	case field.OneofIndex != nil:
+		oneof := generator.CamelCase(msg.OneofDecl[field.GetOneofIndex()].GetName())
+		set = fmt.Sprintf("func() bool {\n_, ok := %s.%s.(*%s)\nreturn ok\n}()", structExpr, oneof, t.oneofWrapper(msg, field))
+	case field.IsMessage(), pointerScalar(proto3, field), !proto3 && field.IsBytes():
+		set = fmt.Sprintf("%s != nil", access)
+	default:
+		// proto3 scalars don't track presence, so like cel we treat the zero value as not set
+		switch field.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_STRING:
+			set = fmt.Sprintf("%s != \"\"", access)
+		case descriptor.FieldDescriptorProto_TYPE_BYTES:
+			set = fmt.Sprintf("len(%s) != 0", access)
+		case descriptor.FieldDescriptorProto_TYPE_BOOL:
+			set = access
+		default:
+			set = fmt.Sprintf("%s != 0", access)
+		}
+	}
+
+	if operand.null != "" {
+		return fmt.Sprintf("func() bool {\nmsg := %s\nreturn msg != nil && %s\n}()", operand.expr, set)
+	}
+	return "(" + set + ")"
+}
+
+func (t *celTranslator) call(e *exprpb.Expr, c *exprpb.Expr_Call) celValue {
+	args := c.Args
+	if c.Target != nil {
+		args = append([]*exprpb.Expr{c.Target}, args...)
+	}
+
+	switch c.Function {
+	case operators.LogicalAnd:
+		return celValue{expr: t.logical("CELAnd", args)}
+	case operators.LogicalOr:
+		return celValue{expr: t.logical("CELOr", args)}
+	case operators.LogicalNot:
+		return celValue{expr: "!(" + t.translate(args[0]).expr + ")"}
+	case operators.Negate:
+		return celValue{expr: "-(" + t.translate(args[0]).expr + ")"}
+	case operators.NotStrictlyFalse, operators.OldNotStrictlyFalse:
+		return celValue{expr: fmt.Sprintf("%s.CELNotStrictlyFalse(%s)", t.p.runtimePkg.Use(), t.lazy(args[0]))}
+	case operators.Equals:
+		return celValue{expr: t.equality(args)}
+	case operators.NotEquals:
+		return celValue{expr: "!" + t.equality(args)}
+	case operators.Less, operators.LessEquals, operators.Greater, operators.GreaterEquals:
+		return celValue{expr: t.compare(c.Function, args)}
+	case operators.Add, operators.Subtract, operators.Multiply, operators.Divide, operators.Modulo:
+		return celValue{expr: t.arithmetic(c.Function, args)}
+	case operators.Conditional:
+		goType := t.goType(t.typeOf(e))
+		return celValue{expr: fmt.Sprintf("func() %s {\nif %s {\nreturn %s\n}\nreturn %s\n}()", goType, t.translate(args[0]).expr, t.translate(args[1]).expr, t.translate(args[2]).expr)}
+	case operators.Index:
+		// go won't compile a constant negative index
+		if c, ok := args[1].GetConstExpr().GetConstantKind().(*exprpb.Constant_Int64Value); ok && c.Int64Value < 0 {
+			t.fail("a negative index is always out of range")
+		}
+		return t.index(t.translate(args[0]), t.translate(args[1]), t.typeOf(args[0]))
+	case operators.In, operators.OldIn:
+		return celValue{expr: t.in(args[0], args[1])}
+	case "size":
+		return celValue{expr: t.size(args[0])}
+	case "contains":
+		return celValue{expr: fmt.Sprintf("%s.Contains(%s, %s)", t.p.stringsPkg.Use(), t.translate(args[0]).expr, t.translate(args[1]).expr)}
+	case "startsWith":
+		return celValue{expr: fmt.Sprintf("%s.HasPrefix(%s, %s)", t.p.stringsPkg.Use(), t.translate(args[0]).expr, t.translate(args[1]).expr)}
+	case "endsWith":
+		return celValue{expr: fmt.Sprintf("%s.HasSuffix(%s, %s)", t.p.stringsPkg.Use(), t.translate(args[0]).expr, t.translate(args[1]).expr)}
+	case "matches":
+		return celValue{expr: t.matches(args[0], args[1])}
+	case "int", "uint", "double", "string":
+		return celValue{expr: t.conversion(c.Function, args[0])}
+	case "duration", "timestamp":
+		return celValue{expr: t.timeLiteral(c.Function, args[0])}
+	}
+	t.fail("%s is not supported", c.Function)
+	return celValue{}
+}
+
+// logical hands both sides to the runtime, which works out the result from whichever side decides it when the other is
+// an error, like cel does
+func (t *celTranslator) logical(function string, args []*exprpb.Expr) string {
+	return fmt.Sprintf("%s.%s(%s, %s)", t.p.runtimePkg.Use(), function, t.lazy(args[0]), t.lazy(args[1]))
+}
+
+// lazy wraps a bool expression in a func so the runtime can decide when to evaluate it and recover its errors
+func (t *celTranslator) lazy(e *exprpb.Expr) string {
+	return fmt.Sprintf("func() bool {\nreturn %s\n}", t.translate(e).expr)
+}
+
+func isZeroConstant(e *exprpb.Expr) bool {
+	switch c := e.GetConstExpr().GetConstantKind().(type) {
+	case *exprpb.Constant_Int64Value:
+		return c.Int64Value == 0
+	case *exprpb.Constant_Uint64Value:
+		return c.Uint64Value == 0
+	case *exprpb.Constant_DoubleValue:
+		return c.DoubleValue == 0
+	}
+	return false
+}
+
+func isNullConstant(e *exprpb.Expr) bool {
+	_, ok := e.GetConstExpr().GetConstantKind().(*exprpb.Constant_NullValue)
+	return ok
+}
+
+func (t *celTranslator) equality(args []*exprpb.Expr) string {
+	// only message fields can be null, so that is the only comparison to null we allow
+	for i, other := range []*exprpb.Expr{args[1], args[0]} {
+		if isNullConstant(args[i]) {
+			v := t.translate(other)
+			if v.null == "" {
+				t.fail("null can only be compared to a message field")
+			}
+			return "(" + v.null + ")"
+		}
+	}
+	return t.equals(t.translate(args[0]).expr, t.translate(args[1]).expr, t.typeOf(args[0]))
+}
+
+// equals is a == b for values of cel type typ
+func (t *celTranslator) equals(a string, b string, typ *exprpb.Type) string {
+	switch {
+	case typ.GetWellKnown() == exprpb.Type_TIMESTAMP:
+		return fmt.Sprintf("%s.Equal(%s)", a, b)
+	case celPrimitive(typ) == exprpb.Type_BYTES:
+		return fmt.Sprintf("%s.Equal(%s, %s)", t.p.bytesPkg.Use(), a, b)
+	case celPrimitive(typ) != exprpb.Type_PRIMITIVE_TYPE_UNSPECIFIED, typ.GetWellKnown() == exprpb.Type_DURATION:
+		return fmt.Sprintf("(%s == %s)", a, b)
+	}
+	t.fail("only scalars, timestamps and durations can be compared")
+	return ""
+}
+
+func (t *celTranslator) compare(function string, args []*exprpb.Expr) string {
+	a, b := t.translate(args[0]).expr, t.translate(args[1]).expr
+	typ := t.typeOf(args[0])
+	op := map[string]string{operators.Less: "<", operators.LessEquals: "<=", operators.Greater: ">", operators.GreaterEquals: ">="}[function]
+
+	switch {
+	case typ.GetWellKnown() == exprpb.Type_TIMESTAMP:
+		switch function {
+		case operators.Less:
+			return fmt.Sprintf("%s.Before(%s)", a, b)
+		case operators.LessEquals:
+			return fmt.Sprintf("!%s.After(%s)", a, b)
+		case operators.Greater:
+			return fmt.Sprintf("%s.After(%s)", a, b)
+		}
+		return fmt.Sprintf("!%s.Before(%s)", a, b)
+	case celPrimitive(typ) == exprpb.Type_BYTES:
+		return fmt.Sprintf("(%s.Compare(%s, %s) %s 0)", t.p.bytesPkg.Use(), a, b, op)
+	case celPrimitive(typ) == exprpb.Type_BOOL:
+		t.fail("bools can't be ordered")
+	case celPrimitive(typ) != exprpb.Type_PRIMITIVE_TYPE_UNSPECIFIED, typ.GetWellKnown() == exprpb.Type_DURATION:
+		return fmt.Sprintf("(%s %s %s)", a, op, b)
+	}
+	t.fail("only scalars, timestamps and durations can be ordered")
+	return ""
+}
+
+func (t *celTranslator) arithmetic(function string, args []*exprpb.Expr) string {
+	a, b := t.translate(args[0]).expr, t.translate(args[1]).expr
+	typeA, typeB := t.typeOf(args[0]), t.typeOf(args[1])
+	op := map[string]string{operators.Add: "+", operators.Subtract: "-", operators.Multiply: "*", operators.Divide: "/", operators.Modulo: "%"}[function]
+	// go won't compile a division by a constant zero
+	if (function == operators.Divide || function == operators.Modulo) && isZeroConstant(args[1]) {
+		t.fail("can not divide by a constant zero")
+	}
+
+	switch {
+	case typeA.GetWellKnown() == exprpb.Type_TIMESTAMP && typeB.GetWellKnown() == exprpb.Type_TIMESTAMP:
+		return fmt.Sprintf("%s.Sub(%s)", a, b)
+	case typeA.GetWellKnown() == exprpb.Type_TIMESTAMP && function == operators.Add:
+		return fmt.Sprintf("%s.Add(%s)", a, b)
+	case typeA.GetWellKnown() == exprpb.Type_TIMESTAMP:
+		return fmt.Sprintf("%s.Add(-(%s))", a, b)
+	case typeB.GetWellKnown() == exprpb.Type_TIMESTAMP:
+		return fmt.Sprintf("%s.Add(%s)", b, a)
+	case celPrimitive(typeA) == exprpb.Type_BYTES, typeA.GetListType() != nil:
+		t.fail("only strings, numbers, timestamps and durations can be added")
+	}
+	// dividing by zero panics, which EvalCEL turns into an error like cel would
+	return fmt.Sprintf("(%s %s %s)", a, op, b)
+}
+
+func (t *celTranslator) index(container celValue, key celValue, typ *exprpb.Type) celValue {
+	if typ.GetListType() != nil {
+		if container.field == nil {
+			return celValue{expr: fmt.Sprintf("%s[%s]", container.expr, key.expr)}
+		}
+		return t.itemValue(fmt.Sprintf("%s[%s]", container.expr, key.expr), container.field)
+	}
+	if typ.GetMapType() != nil && container.field != nil {
+		// a key that isn't there is an error in cel, so the key is checked before it is used
+		entry := t.p.gen.ObjectNamed(container.field.GetTypeName()).(*generator.Descriptor)
+		keyField, valueField := entry.Field[0], entry.Field[1]
+		keyType, _ := t.p.gen.GoType(entry, keyField)
+		checked := fmt.Sprintf("func() %s {\nmapKey := %s\nif _, ok := %s[mapKey]; !ok {\npanic(\"no such key\")\n}\nreturn mapKey\n}()", strings.TrimPrefix(keyType, "*"), t.goKey(key.expr, keyField), container.expr)
+		return t.itemValue(fmt.Sprintf("%s[%s]", container.expr, checked), valueField)
+	}
+	t.fail("only lists and map fields can be indexed")
+	return celValue{}
+}
+
+// itemValue is a single item from a repeated / map field, so it converts like a singular field of the same type
+func (t *celTranslator) itemValue(expr string, field *descriptor.FieldDescriptorProto) celValue {
+	v := celValue{expr: t.convert(expr, field)}
+	if field.IsMessage() {
+		v.null = expr + " == nil"
+	}
+	return v
+}
+
+func (t *celTranslator) mapFields(field *descriptor.FieldDescriptorProto) (*descriptor.FieldDescriptorProto, *descriptor.FieldDescriptorProto) {
+	entry := t.p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+	return entry.Field[0], entry.Field[1]
+}
+
+// goKey converts an int64 / uint64 key back to the go type of the map's key
+func (t *celTranslator) goKey(key string, keyField *descriptor.FieldDescriptorProto) string {
+	switch keyField.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return "int32(" + key + ")"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return "uint32(" + key + ")"
+	}
+	return key
+}
+
+func (t *celTranslator) in(elem *exprpb.Expr, container *exprpb.Expr) string {
+	e := t.translate(elem).expr
+	c := t.translate(container)
+	typ := t.typeOf(container)
+
+	if typ.GetMapType() != nil {
+		if c.field == nil {
+			t.fail("in only works on lists and map fields")
+		}
+		keyField, _ := t.mapFields(c.field)
+		return fmt.Sprintf("func() bool {\n_, ok := %s[%s]\nreturn ok\n}()", c.expr, t.goKey(e, keyField))
+	}
+	item := "item"
+	if c.field != nil {
+		item = t.convert("item", c.field)
+	}
+	return fmt.Sprintf("func() bool {\nfor _, item := range %s {\nif %s {\nreturn true\n}\n}\nreturn false\n}()", c.expr, t.equals(item, e, typ.GetListType().GetElemType()))
+}
+
+func (t *celTranslator) size(arg *exprpb.Expr) string {
+	v := t.translate(arg)
+	typ := t.typeOf(arg)
+	if celPrimitive(typ) == exprpb.Type_STRING {
+		// cel counts code points, not bytes
+		return fmt.Sprintf("int64(%s.RuneCountInString(%s))", t.p.utf8Pkg.Use(), v.expr)
+	}
+	return fmt.Sprintf("int64(len(%s))", v.expr)
+}
+
+func (t *celTranslator) matches(str *exprpb.Expr, pattern *exprpb.Expr) string {
+	s := t.translate(str).expr
+	// a constant pattern can be compiled once like the regex option, otherwise a bad pattern panics and becomes an error
+	if c, ok := pattern.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue); ok {
+		return fmt.Sprintf("%s.MatchString(%s)", t.p.regexVar(t.where, c.StringValue), s)
+	}
+	return fmt.Sprintf("%s.MustCompile(%s).MatchString(%s)", t.p.regexPkg.Use(), t.translate(pattern).expr, s)
+}
+
+func (t *celTranslator) conversion(function string, arg *exprpb.Expr) string {
+	v := t.translate(arg).expr
+	typ := t.typeOf(arg)
+	from := celPrimitive(typ)
+
+	switch function {
+	case "int":
+		if typ.GetWellKnown() == exprpb.Type_TIMESTAMP {
+			return v + ".Unix()"
+		}
+		// the runtime does the conversions that can be out of range, cel errors on those
+		switch from {
+		case exprpb.Type_INT64:
+			return v
+		case exprpb.Type_UINT64:
+			return fmt.Sprintf("%s.CELIntFromUint(%s)", t.p.runtimePkg.Use(), v)
+		case exprpb.Type_DOUBLE:
+			return fmt.Sprintf("%s.CELIntFromDouble(%s)", t.p.runtimePkg.Use(), v)
+		}
+	case "uint":
+		switch from {
+		case exprpb.Type_INT64:
+			return fmt.Sprintf("%s.CELUintFromInt(%s)", t.p.runtimePkg.Use(), v)
+		case exprpb.Type_UINT64:
+			return v
+		case exprpb.Type_DOUBLE:
+			return fmt.Sprintf("%s.CELUintFromDouble(%s)", t.p.runtimePkg.Use(), v)
+		}
+	case "double":
+		if from == exprpb.Type_INT64 || from == exprpb.Type_UINT64 || from == exprpb.Type_DOUBLE {
+			return "float64(" + v + ")"
+		}
+	case "string":
+		switch from {
+		case exprpb.Type_STRING:
+			return v
+		case exprpb.Type_INT64:
+			return fmt.Sprintf("%s.FormatInt(%s, 10)", t.p.strconvPkg.Use(), v)
+		case exprpb.Type_UINT64:
+			return fmt.Sprintf("%s.FormatUint(%s, 10)", t.p.strconvPkg.Use(), v)
+		case exprpb.Type_BOOL:
+			return fmt.Sprintf("%s.FormatBool(%s)", t.p.strconvPkg.Use(), v)
+		}
+	}
+	t.fail("%s() is only supported for numbers", function)
+	return ""
+}
+
+// timeLiteral handles duration("1h") and timestamp("2020-01-01T00:00:00Z"), which have to be given a constant so we
+// can parse them now
+func (t *celTranslator) timeLiteral(function string, arg *exprpb.Expr) string {
+	c, ok := arg.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		t.fail("%s() needs a string constant", function)
+	}
+	if function == "duration" {
+		d, err := time.ParseDuration(c.StringValue)
+		if err != nil {
+			t.fail("%q is not a duration", c.StringValue)
+		}
+		return t.p.durationLiteral(d)
+	}
+	ts, err := time.Parse(time.RFC3339Nano, c.StringValue)
+	if err != nil {
+		t.fail("%q is not an RFC 3339 time", c.StringValue)
+	}
+	return t.p.timeLiteral(ts)
+}
+
+func (t *celTranslator) list(e *exprpb.Expr, l *exprpb.Expr_CreateList) celValue {
+	elems := []string{}
+	for _, elem := range l.Elements {
+		elems = append(elems, t.translate(elem).expr)
+	}
+	return celValue{expr: fmt.Sprintf("[]%s{%s}", t.goType(t.typeOf(e).GetListType().GetElemType()), strings.Join(elems, ", "))}
+}
+
+// comprehension covers the all, exists and exists_one macros, map and filter build lists which we can't translate
+func (t *celTranslator) comprehension(e *exprpb.Expr, c *exprpb.Expr_Comprehension) celValue {
+	if t.typeOf(c.AccuInit).GetListType() != nil {
+		t.fail("the map and filter macros are not supported")
+	}
+	accuType := t.goType(t.typeOf(c.AccuInit))
+	iterRange := t.translate(c.IterRange)
+	rangeType := t.typeOf(c.IterRange)
+	iterVar, accuVar := "cel_"+c.IterVar, "cel_"+c.AccuVar
+
+	var loop string
+	switch {
+	case rangeType.GetMapType() != nil && iterRange.field != nil:
+		keyField, _ := t.mapFields(iterRange.field)
+		loop = fmt.Sprintf("for key := range %s {\n%s := %s\n", iterRange.expr, iterVar, t.convert("key", keyField))
+	case rangeType.GetListType() != nil && iterRange.field != nil:
+		loop = fmt.Sprintf("for _, item := range %s {\n%s := %s\n", iterRange.expr, iterVar, t.convert("item", iterRange.field))
+	case rangeType.GetListType() != nil:
+		loop = fmt.Sprintf("for _, %s := range %s {\n", iterVar, iterRange.expr)
+	default:
+		t.fail("macros only work on lists and map fields")
+	}
+
+	init := t.translate(c.AccuInit).expr
+	saved := t.vars
+	t.vars = map[string]celValue{}
+	for name, v := range saved {
+		t.vars[name] = v
+	}
+	t.vars[c.IterVar] = celValue{expr: iterVar}
+	if rangeType.GetListType() != nil && iterRange.field != nil && iterRange.field.IsMessage() {
+		t.vars[c.IterVar] = celValue{expr: iterVar, null: iterVar + " == nil"}
+	}
+	// all and exists keep going after an error, since a later item can still decide the result, so their bool is kept
+	// as a func that gives it back or panics again with the error
+	t.vars[c.AccuVar] = celValue{expr: accuVar}
+	if accuType == "bool" {
+		t.vars[c.AccuVar] = celValue{expr: accuVar + "()"}
+	}
+	cond := t.translate(c.LoopCondition).expr
+	step := t.translate(c.LoopStep).expr
+	result := t.translate(c.Result).expr
+	t.vars = saved
+
+	declare := fmt.Sprintf("var %s %s = %s", accuVar, accuType, init)
+	assign := fmt.Sprintf("%s = %s", accuVar, step)
+	if accuType == "bool" {
+		declare = fmt.Sprintf("%s := %s.CELBool(func() bool {\nreturn %s\n})", accuVar, t.p.runtimePkg.Use(), init)
+		assign = fmt.Sprintf("%s = %s.CELBool(func() bool {\nreturn %s\n})", accuVar, t.p.runtimePkg.Use(), step)
+	}
+	return celValue{expr: fmt.Sprintf("func() bool {\n%s\n%s_ = %s\nif !(%s) {\nbreak\n}\n%s\n}\nreturn %s\n}()", declare, loop, iterVar, cond, assign, result)}
+}
+
+// goType is the go type we use for values of cel type typ
+func (t *celTranslator) goType(typ *exprpb.Type) string {
+	switch typ.GetWellKnown() {
+	case exprpb.Type_TIMESTAMP:
+		return t.p.timePkg.Use() + ".Time"
+	case exprpb.Type_DURATION:
+		return t.p.timePkg.Use() + ".Duration"
+	}
+	switch celPrimitive(typ) {
+	case exprpb.Type_INT64:
+		return "int64"
+	case exprpb.Type_UINT64:
+		return "uint64"
+	case exprpb.Type_DOUBLE:
+		return "float64"
+	case exprpb.Type_STRING:
+		return "string"
+	case exprpb.Type_BOOL:
+		return "bool"
+	case exprpb.Type_BYTES:
+		return "[]byte"
+	}
+	t.fail("only scalars, timestamps and durations are supported here")
+	return ""
+}
+
+// celPrimitive is the primitive type of typ, wrappers count as the type they wrap since we unwrap them
+func celPrimitive(typ *exprpb.Type) exprpb.Type_PrimitiveType {
+	if typ.GetPrimitive() != exprpb.Type_PRIMITIVE_TYPE_UNSPECIFIED {
+		return typ.GetPrimitive()
+	}
+	return typ.GetWrapper()
+}
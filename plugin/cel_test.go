@@ -0,0 +1,251 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// celCases are run through cel-go and through our translation, the results have to be the same.  The inputs are vars
+// so errors happen when the expression runs, a constant zero divisor or negative index fails generation instead
+var celCases = []struct {
+	name       string
+	expression string
+}{
+	{"divide", "six / two == 3"},
+	{"divide by zero", "six / zero == 0"},
+	{"modulo by zero", "six % zero == 0"},
+	{"double divide by zero", "half / dzero > 1.0"},
+	{"uint wraps", "uzero - 1u > 0u"},
+	{"index", "nums[2] == 3"},
+	{"index out of range", "nums[3] == 3"},
+	{"negative index", "nums[zero - 1] == 3"},
+	{"index empty list", "none[0] == 0"},
+	{"or error then true", "six / zero == 0 || true"},
+	{"or true then error", "true || six / zero == 0"},
+	{"or error then false", "six / zero == 0 || false"},
+	{"or false then error", "false || nums[3] == 3"},
+	{"or both errors", "nums[3] == 3 || six / zero == 0"},
+	{"and error then false", "nums[3] == 3 && false"},
+	{"and false then error", "false && nums[3] == 3"},
+	{"and error then true", "six / zero == 0 && true"},
+	{"and true then error", "true && six / zero == 0"},
+	{"not error", "!(six / zero == 0)"},
+	{"nested", "(six / zero == 0 && false) || (nums[3] == 3 || true)"},
+	{"conditional skips error", "zero == 0 ? true : six / zero == 0"},
+	{"conditional takes error", "zero != 0 ? true : six / zero == 0"},
+	{"conditional error", "six / zero == 0 ? true : false"},
+	{"exists after error", "[0, 2].exists(x, six / x == 3)"},
+	{"exists only error", "[0].exists(x, six / x == 3)"},
+	{"exists none", "[1, 2].exists(x, six / x == 4)"},
+	{"all false after error", "[0, 1].all(x, six / x == 3)"},
+	{"all true after error", "[0, 2].all(x, six / x == 3)"},
+	{"all", "nums.all(x, x > 0)"},
+	{"exists_one error", "[0, 2].exists_one(x, six / x == 3)"},
+	{"exists_one", "nums.exists_one(x, x > 2)"},
+	{"in", "2 in nums"},
+	{"size counts code points", "size(s) == 5"},
+	{"size method", "s.size() == 5"},
+	{"bytes size", "size(b) == 3"},
+	{"contains", "s.contains(\"ll\")"},
+	{"starts with", "s.startsWith(\"hé\")"},
+	{"ends with", "s.endsWith(\"lo\")"},
+	{"doesn't end with", "s.endsWith(\"hé\")"},
+	{"concat", "s + \"!\" == \"héllo!\""},
+	{"matches", "s.matches(\"^h.llo$\")"},
+	{"doesn't match", "s.matches(\"^hello$\")"},
+	{"bad pattern", "s.matches(pattern)"},
+	{"bad pattern absorbed", "s.matches(pattern) || true"},
+	{"string compare", "s > \"hello\""},
+	{"bytes equal", "b == b'abc'"},
+	{"bytes compare", "b < b'abd'"},
+	{"string of int", "string(six) == \"6\""},
+	{"string of uint", "string(uzero) == \"0\""},
+	{"int of double rounds", "int(half) == 1"},
+	{"int of negative double rounds", "int(-half) == -1"},
+	{"int of huge double", "int(huge) > 0"},
+	{"int of uint", "int(uzero) == 0"},
+	{"int of huge uint", "int(uhuge) > 0"},
+	{"uint of negative int", "uint(zero - 1) > 0u"},
+	{"uint of double", "uint(half) == 1u"},
+	{"uint of negative double", "uint(-half) == 0u"},
+	{"double of int", "double(six) / 4.0 == 1.5"},
+	{"duration", "duration(\"1h\") > duration(\"59m\")"},
+}
+
+func TestCELMatchesCELGo(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is needed to run the translated expressions")
+	}
+
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewIdent("six", decls.Int, nil),
+		decls.NewIdent("two", decls.Int, nil),
+		decls.NewIdent("zero", decls.Int, nil),
+		decls.NewIdent("uzero", decls.Uint, nil),
+		decls.NewIdent("half", decls.Double, nil),
+		decls.NewIdent("dzero", decls.Double, nil),
+		decls.NewIdent("huge", decls.Double, nil),
+		decls.NewIdent("uhuge", decls.Uint, nil),
+		decls.NewIdent("nums", decls.NewListType(decls.Int), nil),
+		decls.NewIdent("none", decls.NewListType(decls.Int), nil),
+		decls.NewIdent("s", decls.String, nil),
+		decls.NewIdent("b", decls.Bytes, nil),
+		decls.NewIdent("pattern", decls.String, nil),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the same values as the go vars in celProgram
+	activation := map[string]interface{}{
+		"six": 6, "two": 2, "zero": 0, "uzero": uint64(0), "half": 0.5, "dzero": 0.0,
+		"huge": 1e20, "uhuge": uint64(math.MaxUint64),
+		"nums": []int64{1, 2, 3}, "none": []int64{}, "s": "héllo", "b": []byte("abc"), "pattern": "[",
+	}
+	vars := map[string]celValue{}
+	for name := range activation {
+		vars[name] = celValue{expr: name}
+	}
+
+	p := newCELTestPlugin()
+	want := []string{}
+	calls := []string{}
+	for _, c := range celCases {
+		ast, issues := env.Compile(c.expression)
+		if issues != nil && issues.Err() != nil {
+			t.Fatalf("%s: %s", c.name, issues.Err())
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		out, _, err := prg.Eval(activation)
+		want = append(want, celGoResult(out, err))
+
+		checked, err := cel.AstToCheckedExpr(ast)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		translator := &celTranslator{p: p, where: c.name, checked: checked, vars: vars}
+		calls = append(calls, fmt.Sprintf("show(%s.EvalCEL(func() bool {\nreturn %s\n}))", p.runtimePkg.Use(), translator.translate(checked.Expr).expr))
+	}
+
+	got := runCELProgram(t, celProgram(p, calls))
+	if len(got) != len(celCases) {
+		t.Fatalf("expected %d results, got %d: %v", len(celCases), len(got), got)
+	}
+	for i, c := range celCases {
+		if got[i] != want[i] {
+			t.Errorf("%s: %s is %s in cel-go but %s translated", c.name, c.expression, want[i], got[i])
+		}
+	}
+}
+
+func newCELTestPlugin() *Plugin {
+	p := New().(*Plugin)
+	p.Init(generator.New())
+	p.imp = generator.NewPluginImports(p.gen)
+	p.regexPkg = p.imp.NewImport("regexp")
+	p.stringsPkg = p.imp.NewImport("strings")
+	p.bytesPkg = p.imp.NewImport("bytes")
+	p.strconvPkg = p.imp.NewImport("strconv")
+	p.timePkg = p.imp.NewImport("time")
+	p.utf8Pkg = p.imp.NewImport("unicode/utf8")
+	p.runtimePkg = p.imp.NewImport(runtimePath)
+	p.regexes = &regexVars{prefix: "regex_", names: map[string]string{}}
+	return p
+}
+
+func celGoResult(out ref.Val, err error) string {
+	if err != nil || types.IsError(out) {
+		return "error"
+	}
+	return fmt.Sprint(out.Value())
+}
+
+// celProgram is a main package that prints the result of each call on its own line.  The generator only writes out
+// while it is generating a file, so the imports and regex vars are put together here
+func celProgram(p *Plugin, calls []string) string {
+	imports := []string{}
+	for _, pkg := range []generator.Single{p.regexPkg, p.stringsPkg, p.bytesPkg, p.strconvPkg, p.timePkg, p.utf8Pkg, p.runtimePkg} {
+		if pkg.IsUsed() {
+			imports = append(imports, fmt.Sprintf("%s %q", pkg.Name(), pkg.Location()))
+		}
+	}
+	regexVars := []string{}
+	for _, pattern := range p.regexes.patterns {
+		regexVars = append(regexVars, fmt.Sprintf("var %s = regexp.MustCompile(%q)", p.regexes.names[pattern], pattern))
+	}
+
+	return fmt.Sprintf(`package main
+
+import (
+	"fmt"
+	"math"
+	%s
+)
+
+%s
+
+var (
+	six, two, zero = int64(6), int64(2), int64(0)
+	uzero, uhuge   = uint64(0), uint64(math.MaxUint64)
+	half, dzero    = 0.5, 0.0
+	huge           = 1e20
+	nums, none     = []int64{1, 2, 3}, []int64{}
+	s, pattern     = "héllo", "["
+	b              = []byte("abc")
+)
+
+func show(result bool, err error) {
+	if err != nil {
+		fmt.Println("error")
+		return
+	}
+	fmt.Println(result)
+}
+
+func main() {
+%s
+}
+`, strings.Join(imports, "\n"), strings.Join(regexVars, "\n"), strings.Join(calls, "\n"))
+}
+
+// runCELProgram runs the program from inside the module so it can import the runtime package
+func runCELProgram(t *testing.T, program string) []string {
+	trackRuntime(t)
+
+	dir, err := ioutil.TempDir(".", "_celtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(program), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("running the translated expressions: %s\n%s\n%s", err, stderr.String(), program)
+	}
+	return strings.Split(strings.TrimSpace(string(out)), "\n")
+}
+
+func TestCELGenerated(t *testing.T) {
+	testGenerated(t, "cel")
+}
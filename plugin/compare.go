@@ -0,0 +1,196 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// fieldComparison is one of the *_field options, op is the go operator that has to hold for the value to be valid
+type fieldComparison struct {
+	rule     string
+	other    string
+	op       string
+	errorMsg string
+}
+
+func fieldComparisons(v *pb.FieldValidation) []fieldComparison {
+	comparisons := []fieldComparison{}
+	if v == nil {
+		return comparisons
+	}
+	if v.EqField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.eq", v.GetEqField(), "==", "{field} must equal {value}"})
+	}
+	if v.NeField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.ne", v.GetNeField(), "!=", "{field} must not equal {value}"})
+	}
+	if v.GtField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.gt", v.GetGtField(), ">", "{field} must be greater than {value}"})
+	}
+	if v.GteField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.gte", v.GetGteField(), ">=", "{field} must be greater than or equal to {value}"})
+	}
+	if v.LtField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.lt", v.GetLtField(), "<", "{field} must be less than {value}"})
+	}
+	if v.LteField != nil {
+		comparisons = append(comparisons, fieldComparison{"field.lte", v.GetLteField(), "<=", "{field} must be less than or equal to {value}"})
+	}
+	return comparisons
+}
+
+// generateFieldComparisonCode compares fields to their siblings, this happens after every field has had its own rules
+// checked
+func (p *Plugin) generateFieldComparisonCode(message *generator.Descriptor, mv *pb.MessageValidation) {
+	for _, field := range message.Field {
+		v := getFieldValidation(field)
+		if v == nil || v.DoNotValidate != nil {
+			continue
+		}
+		comparisons := fieldComparisons(v)
+		if len(comparisons) == 0 {
+			continue
+		}
+
+		fieldName := field.GetName()
+		kind := comparisonKind(field)
+		if field.IsRepeated() || kind == "" {
+			p.gen.Fail(fmt.Sprintf("field %s: only singular scalar and enum fields can be compared to other fields", fieldName))
+		}
+		for _, c := range comparisons {
+			other := comparedField(message, c.other)
+			if other == nil {
+				p.gen.Fail(fmt.Sprintf("field %s: %s is not a field of %s", fieldName, c.other, message.GetName()))
+			}
+			if other == field {
+				p.gen.Fail(fmt.Sprintf("field %s: can not be compared to itself", fieldName))
+			}
+			if other.IsRepeated() || comparisonKind(other) != kind || (kind == "enum" && other.GetTypeName() != field.GetTypeName()) {
+				p.gen.Fail(fmt.Sprintf("field %s: can not be compared to %s, they are different types", fieldName, c.other))
+			}
+			if (kind == "bool" || kind == "bytes" || kind == "enum") && c.op != "==" && c.op != "!=" {
+				p.gen.Fail(fmt.Sprintf("field %s: %s values can only use eq_field and ne_field", fieldName, kind))
+			}
+
+			// fields that can be unset, proto2 pointers and oneof cases, compare as their default value when they are,
+			// the same as in cel.  Reading those takes a variable, the block keeps them apart from other comparisons
+			block := p.needsScalarVar(field) || p.needsScalarVar(other)
+			if block {
+				p.P(`{`)
+			}
+			fieldValue := p.scalarValue(message, field, "fieldValue")
+			otherValue := p.scalarValue(message, other, "otherValue")
+
+			actual := fieldValue
+			// the go types only differ when the proto types do, i.e. int32 and int64, so widen both sides
+			if field.GetType() != other.GetType() {
+				conversion := map[string]string{"int": "int64", "uint": "uint64", "float": "float64"}[kind]
+				fieldValue = fmt.Sprintf("%s(%s)", conversion, fieldValue)
+				otherValue = fmt.Sprintf("%s(%s)", conversion, otherValue)
+			}
+
+			if kind == "bytes" {
+				not := "!"
+				if c.op == "!=" {
+					not = ""
+				}
+				p.P(`if %s%s.Equal(%s, %s) {`, not, p.bytesPkg.Use(), fieldValue, otherValue)
+			} else {
+				p.P(`if !(%s %s %s) {`, fieldValue, c.op, otherValue)
+			}
+			p.generateFieldErrorCode(fieldName, jsonName(field), "", c.rule, c.other, actual, c.errorMsg, v, mv, "")
+			p.P(`}`)
+			if block {
+				p.P(`}`)
+			}
+		}
+	}
+}
+
+// needsScalarVar is true for the fields scalarValue has to declare a variable for
+func (p *Plugin) needsScalarVar(field *descriptor.FieldDescriptorProto) bool {
+	return field.OneofIndex != nil || p.isPointerScalar(field)
+}
+
+// scalarValue is a go expression for the value of a scalar field of m.  Fields are read directly since gogo can be told
+// not to generate getters, so a proto2 field or oneof case that can be unset is read into the variable name first,
+// which gets the field's default value when it isn't set
+func (p *Plugin) scalarValue(message *generator.Descriptor, field *descriptor.FieldDescriptorProto, name string) string {
+	accessor := "m." + generator.CamelCase(field.GetName())
+	switch {
+	case field.OneofIndex != nil:
+		p.P(`%s := %s`, name, p.scalarDefault(message, field))
+		p.P(`if o, ok := m.%s.(*%s); ok {`, generator.CamelCase(message.OneofDecl[field.GetOneofIndex()].GetName()), oneofTypeName(message, field))
+		p.P(`%s = o.%s`, name, generator.CamelCase(field.GetName()))
+		p.P(`}`)
+	case p.isPointerScalar(field):
+		p.P(`%s := %s`, name, p.scalarDefault(message, field))
+		p.P(`if %s != nil {`, accessor)
+		p.P(`%s = *%s`, name, accessor)
+		p.P(`}`)
+	default:
+		return accessor
+	}
+	return name
+}
+
+// scalarDefault is the value a scalar field has when it isn't set, a proto2 default or the zero value of its go type
+func (p *Plugin) scalarDefault(message *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
+	if field.DefaultValue != nil {
+		// generated as a constant next to the message
+		return fmt.Sprintf("Default_%s_%s", generator.CamelCaseSlice(message.TypeName()), generator.CamelCase(field.GetName()))
+	}
+	goType, _ := p.gen.GoType(message, field)
+	goType = strings.TrimPrefix(goType, "*")
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		// which is the first value for a proto2 enum
+		p.gen.RecordTypeUse(field.GetTypeName())
+		enum := p.gen.ObjectNamed(field.GetTypeName()).(*generator.EnumDescriptor)
+		return fmt.Sprintf("%s(%d)", goType, enum.Value[0].GetNumber())
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return `""`
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "false"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "[]byte(nil)"
+	}
+	return goType + "(0)"
+}
+
+func comparedField(message *generator.Descriptor, name string) *descriptor.FieldDescriptorProto {
+	for _, field := range message.Field {
+		if field.GetName() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// comparisonKind groups the scalar types that can be compared with each other, it is empty for anything else
+func comparisonKind(field *descriptor.FieldDescriptorProto) string {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return "int"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return "uint"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return "float"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return "string"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "bytes"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "bool"
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return "enum"
+	}
+	return ""
+}
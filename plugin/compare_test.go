@@ -0,0 +1,32 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	testGenerated(t, "compare")
+}
+
+func TestCompareFailures(t *testing.T) {
+	for want, field := range map[string]string{
+		"field a: nope is not a field of Bad":                         `int32 a = 3 [(validation.field).eq_field = "nope"];`,
+		"field a: can not be compared to itself":                      `int32 a = 3 [(validation.field).eq_field = "a"];`,
+		"field a: can not be compared to s, they are different types": `int32 a = 3 [(validation.field).eq_field = "s"];`,
+		"field b: bool values can only use eq_field and ne_field":     `bool b = 3 [(validation.field).gt_field = "flag"];`,
+	} {
+		msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "validation.proto";
+message Bad {
+  string s = 1;
+  bool flag = 2;
+  ` + field + `
+}
+`})
+		if !strings.Contains(msg, want) {
+			t.Errorf("unexpected error: %s", msg)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// generateConditionalCode handles required_if, required_unless and forbidden_if, like the comparisons these run after
+// every field has had its own rules checked.  Each option gives one error no matter how many of its conditions match
+func (p *Plugin) generateConditionalCode(message *generator.Descriptor, mv *pb.MessageValidation) {
+	for _, field := range message.Field {
+		v := getFieldValidation(field)
+		if v == nil || v.DoNotValidate != nil {
+			continue
+		}
+		for _, c := range []struct {
+			rule       string
+			check      string
+			errorMsg   string
+			conditions []*pb.FieldCondition
+		}{
+			{"field.required_if", "(%s) && !%s", "{field} is required when {value}", v.RequiredIf},
+			{"field.required_unless", "!(%s) && !%s", "{field} is required unless {value}", v.RequiredUnless},
+			{"field.forbidden_if", "(%s) && %s", "{field} must not be set when {value}", v.ForbiddenIf},
+		} {
+			if len(c.conditions) == 0 {
+				continue
+			}
+			// conditions on fields that can be unset read them into variables, the block keeps those to this option
+			block := false
+			for _, condition := range c.conditions {
+				if other := comparedField(message, condition.GetField()); other != nil && p.needsScalarVar(other) {
+					block = true
+				}
+			}
+			if block {
+				p.P(`{`)
+			}
+			condition, description := p.conditionCode(message, field, c.conditions)
+			init, set := p.fieldSetCode(message, field)
+			p.P(`if %s%s {`, init, fmt.Sprintf(c.check, condition, set))
+			p.generateFieldErrorCode(field.GetName(), jsonName(field), "", c.rule, description, "", c.errorMsg, v, mv, "")
+			p.P(`}`)
+			if block {
+				p.P(`}`)
+			}
+		}
+	}
+}
+
+// conditionCode ors the conditions together, the description is what {value} becomes in the error, i.e.
+// "payment_type is CARD or payment_type is BANK"
+func (p *Plugin) conditionCode(message *generator.Descriptor, field *descriptor.FieldDescriptorProto, conditions []*pb.FieldCondition) (string, string) {
+	fieldName := field.GetName()
+	code := []string{}
+	descriptions := []string{}
+	for i, c := range conditions {
+		other := comparedField(message, c.GetField())
+		if other == nil {
+			p.gen.Fail(fmt.Sprintf("field %s: %s is not a field of %s", fieldName, c.GetField(), message.GetName()))
+		}
+		if other == field {
+			p.gen.Fail(fmt.Sprintf("field %s: can not have a condition on itself", fieldName))
+		}
+		kind := comparisonKind(other)
+		if other.IsRepeated() || kind == "" || kind == "bytes" {
+			p.gen.Fail(fmt.Sprintf("field %s: conditions can only use singular scalar and enum fields, %s is not one", fieldName, c.GetField()))
+		}
+
+		// like in cel and the comparisons, a proto2 field or oneof case that isn't set has its default value
+		otherValue := p.scalarValue(message, other, fmt.Sprintf("value%d", i))
+		value := c.GetValue()
+		var err error
+		switch kind {
+		case "int":
+			_, err = strconv.ParseInt(value, 10, bitSize(other))
+		case "uint":
+			_, err = strconv.ParseUint(value, 10, bitSize(other))
+		case "float":
+			var f float64
+			f, err = strconv.ParseFloat(value, bitSize(other))
+			if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+				err = fmt.Errorf("%s is not a number", value)
+			}
+		case "bool":
+			var b bool
+			b, err = strconv.ParseBool(value)
+			value = strconv.FormatBool(b)
+		case "string":
+			value = strconv.Quote(value)
+		case "enum":
+			// compared by number like the other enum options, so we don't need the enum's go type
+			enum := p.gen.ObjectNamed(other.GetTypeName()).(*generator.EnumDescriptor)
+			otherValue = "int32(" + otherValue + ")"
+			value = p.resolveEnumValues(fieldName, enum, []string{value})[0]
+		}
+		if err != nil {
+			p.gen.Fail(fmt.Sprintf("field %s: %s is not a valid value for %s", fieldName, c.GetValue(), c.GetField()))
+		}
+
+		code = append(code, fmt.Sprintf("%s == %s", otherValue, value))
+		descriptions = append(descriptions, fmt.Sprintf("%s is %s", c.GetField(), c.GetValue()))
+	}
+	return strings.Join(code, " || "), strings.Join(descriptions, " or ")
+}
+
+// fieldSetCode is a go expression that is true when the field isn't its default value.  A oneof case needs a type
+// assertion for that, init is the statement for it that goes at the start of the if
+func (p *Plugin) fieldSetCode(message *generator.Descriptor, field *descriptor.FieldDescriptorProto) (string, string) {
+	accessor := "m." + generator.CamelCase(field.GetName())
+	switch {
+	case field.OneofIndex != nil:
+		oneofAccessor := "m." + generator.CamelCase(message.OneofDecl[field.GetOneofIndex()].GetName())
+		return fmt.Sprintf("_, set := %s.(*%s); ", oneofAccessor, oneofTypeName(message, field)), "set"
+	case field.IsRepeated(), field.IsBytes():
+		return "", fmt.Sprintf("(len(%s) != 0)", accessor)
+	case field.IsMessage(), p.isPointerScalar(field):
+		return "", fmt.Sprintf("(%s != nil)", accessor)
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return "", fmt.Sprintf(`(%s != "")`, accessor)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "", accessor
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		p.gen.Fail(fmt.Sprintf("field %s: groups can not be conditional", field.GetName()))
+	}
+	return "", fmt.Sprintf("(%s != 0)", accessor)
+}
+
+// bitSize is how many bits the go type of an int, uint or float field has, values for it have to fit
+func bitSize(field *descriptor.FieldDescriptorProto) int {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return 32
+	}
+	return 64
+}
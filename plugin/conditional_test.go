@@ -0,0 +1,41 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConditional(t *testing.T) {
+	testGenerated(t, "conditional")
+}
+
+func TestConditionalValues(t *testing.T) {
+	for want, condition := range map[string]string{
+		"field a: 3000000000 is not a valid value for small": `{field: "small", value: "3000000000"}`,
+		"field a: -1 is not a valid value for count":         `{field: "count", value: "-1"}`,
+		"field a: 5000000000 is not a valid value for count": `{field: "count", value: "5000000000"}`,
+		"field a: NaN is not a valid value for rate":         `{field: "rate", value: "NaN"}`,
+		"field a: inf is not a valid value for rate":         `{field: "rate", value: "inf"}`,
+		"field a: 1e40 is not a valid value for rate":        `{field: "rate", value: "1e40"}`,
+		"field a: x is not a valid value for big":            `{field: "big", value: "x"}`,
+		"field a: maybe is not a valid value for flag":       `{field: "flag", value: "maybe"}`,
+		"field a: can not have a condition on itself":        `{field: "a", value: "x"}`,
+		"field a: nope is not a field of Bad":                `{field: "nope", value: "1"}`,
+	} {
+		msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "validation.proto";
+message Bad {
+  int32 small = 1;
+  uint32 count = 2;
+  float rate = 3;
+  int64 big = 4;
+  bool flag = 5;
+  string a = 6 [(validation.field).required_if = ` + condition + `];
+}
+`})
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected %q, got %s", want, msg)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// enums are compared by number so we don't need to know the go type name of the enum, or import its package
+func (p *Plugin) generateEnumValidationCode(fieldName string, fieldValue string, enumField *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	enum := p.gen.ObjectNamed(enumField.GetTypeName()).(*generator.EnumDescriptor)
+
+	if v.DefinedOnly != nil && v.GetDefinedOnly() {
+		numbers := []string{}
+		for _, value := range enum.Value {
+			numbers = append(numbers, strconv.Itoa(int(value.GetNumber())))
+		}
+		p.P(`switch int32(%s) {`, fieldValue)
+		p.P(`case %s:`, strings.Join(uniqueStrings(numbers), ", "))
+		p.P(`default:`)
+		p.generateErrorCode(fieldName, "enum.defined_only", "", fieldValue, "{field} must be a defined enum value", v, mv, field, "")
+		p.P(`}`)
+	}
+	if len(v.EnumIn) != 0 {
+		numbers := p.resolveEnumValues(fieldName, enum, v.EnumIn)
+		p.P(`switch int32(%s) {`, fieldValue)
+		p.P(`case %s:`, strings.Join(numbers, ", "))
+		p.P(`default:`)
+		p.generateErrorCode(fieldName, "enum.in", strings.Join(v.EnumIn, ", "), fieldValue, "{field} must be one of {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if len(v.EnumNotIn) != 0 {
+		numbers := p.resolveEnumValues(fieldName, enum, v.EnumNotIn)
+		p.P(`switch int32(%s) {`, fieldValue)
+		p.P(`case %s:`, strings.Join(numbers, ", "))
+		p.generateErrorCode(fieldName, "enum.not_in", strings.Join(v.EnumNotIn, ", "), fieldValue, "{field} must not be one of {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+}
+
+// resolveEnumValues turns the names / numbers given in an option into the numbers we compare against, anything that
+// isn't a name in the enum or a number fails generation
+func (p *Plugin) resolveEnumValues(fieldName string, enum *generator.EnumDescriptor, values []string) []string {
+	numbers := []string{}
+	for _, val := range values {
+		found := false
+		for _, ev := range enum.Value {
+			if ev.GetName() == val {
+				numbers = append(numbers, strconv.Itoa(int(ev.GetNumber())))
+				found = true
+				break
+			}
+		}
+		if !found {
+			num, err := strconv.ParseInt(val, 10, 32)
+			if err != nil {
+				p.gen.Fail(fmt.Sprintf("field %s: %s is not a value of enum %s", fieldName, val, enum.GetName()))
+			}
+			numbers = append(numbers, strconv.Itoa(int(num)))
+		}
+	}
+	// aliases can give us the same number twice, which go won't allow in a case
+	return uniqueStrings(numbers)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, val := range values {
+		if !seen[val] {
+			seen[val] = true
+			unique = append(unique, val)
+		}
+	}
+	return unique
+}
+
+func isEnum(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetType() == descriptor.FieldDescriptorProto_TYPE_ENUM
+}
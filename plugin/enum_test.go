@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestEnum(t *testing.T) {
+	testGenerated(t, "enums")
+}
@@ -0,0 +1,128 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// every path a mask can have is worked out here from field_mask_of, so the generated code is mostly a switch over them.
+// fieldValue is the pointer to the FieldMask itself
+func (p *Plugin) generateFieldMaskValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if v.FieldMaskOf == nil {
+		if len(v.FieldMaskDisallow) != 0 {
+			p.gen.Fail(fmt.Sprintf("field %s: field_mask_disallow needs field_mask_of", fieldName))
+		}
+		return
+	}
+
+	paths := p.fieldMaskPaths(fieldName, v.GetFieldMaskOf())
+	known := map[string]bool{}
+	for _, path := range paths {
+		known[path] = true
+	}
+	for _, d := range v.FieldMaskDisallow {
+		if !known[d] {
+			p.gen.Fail(fmt.Sprintf("field %s: field_mask_disallow path %s is not in %s", fieldName, d, v.GetFieldMaskOf()))
+		}
+	}
+
+	allowed := []string{}
+	for _, path := range paths {
+		if !underFieldMaskPath(path, v.FieldMaskDisallow) {
+			allowed = append(allowed, path)
+		}
+	}
+
+	// disallowed paths are matched by prefix, a message that contains itself means there can be more under them than
+	// we listed
+	p.P(`for _, path := range %s.Paths {`, fieldValue)
+	p.P(`switch {`)
+	for _, d := range uniqueStrings(v.FieldMaskDisallow) {
+		p.P(`case path == %q, %s.HasPrefix(path, %q):`, d, p.stringsPkg.Use(), d+".")
+		p.generateErrorCode(fieldName, "field_mask.disallow", d, "path", "{field} can not include {value}", v, mv, field, "")
+	}
+	p.P(`default:`)
+	p.P(`switch path {`)
+	if len(allowed) != 0 {
+		p.P(`case %s:`, quoteStrings(allowed))
+	}
+	p.P(`default:`)
+	p.generateErrorCode(fieldName, "field_mask.unknown", "", "path", "{field} has a path that is not a field of "+v.GetFieldMaskOf(), v, mv, field, "")
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// underFieldMaskPath is true if path is one of parents or a field under one of them
+func underFieldMaskPath(path string, parents []string) bool {
+	for _, parent := range parents {
+		if path == parent || strings.HasPrefix(path, parent+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldMaskPaths is every path into the message, singular messages are followed so their fields are included as
+// parent.child.  A message that contains itself is only followed once, otherwise there would be no end to the paths
+func (p *Plugin) fieldMaskPaths(fieldName string, typeName string) []string {
+	typeName = "." + strings.TrimPrefix(typeName, ".")
+	if !p.messageExists(typeName) {
+		p.gen.Fail(fmt.Sprintf("field %s: field_mask_of message %s does not exist, it needs the package and has to be in this file or one it imports", fieldName, strings.TrimPrefix(typeName, ".")))
+	}
+	msg := p.gen.ObjectNamed(typeName).(*generator.Descriptor)
+
+	paths := []string{}
+	p.collectFieldMaskPaths(msg, "", map[*generator.Descriptor]bool{msg: true}, &paths)
+	return paths
+}
+
+func (p *Plugin) collectFieldMaskPaths(msg *generator.Descriptor, prefix string, seen map[*generator.Descriptor]bool, paths *[]string) {
+	for _, field := range msg.Field {
+		path := prefix + field.GetName()
+		*paths = append(*paths, path)
+		if !field.IsMessage() || field.IsRepeated() || isWKT(field.GetTypeName()) {
+			continue
+		}
+		child := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+		if seen[child] {
+			continue
+		}
+		seen[child] = true
+		p.collectFieldMaskPaths(child, path+".", seen, paths)
+		delete(seen, child)
+	}
+}
+
+// messageExists checks typeName (with the leading .) against every message protoc gave us, ObjectNamed would just fail
+// with a message that doesn't say which option was wrong
+func (p *Plugin) messageExists(typeName string) bool {
+	for _, file := range p.gen.AllFiles().File {
+		prefix := "."
+		if file.GetPackage() != "" {
+			prefix += file.GetPackage() + "."
+		}
+		if messageInList(prefix, file.MessageType, typeName) {
+			return true
+		}
+	}
+	return false
+}
+
+func messageInList(prefix string, messages []*descriptor.DescriptorProto, typeName string) bool {
+	for _, msg := range messages {
+		name := prefix + msg.GetName()
+		if name == typeName || messageInList(name+".", msg.NestedType, typeName) {
+			return true
+		}
+	}
+	return false
+}
+
+func isFieldMask(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == wktBasePath+"FieldMask"
+}
@@ -0,0 +1,30 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFieldMasks(t *testing.T) {
+	testGenerated(t, "fieldmasks")
+}
+
+func TestFieldMaskFailures(t *testing.T) {
+	for want, rules := range map[string]string{
+		"field mask: field_mask_of message pb.Nope does not exist":   `field_mask_of: "pb.Nope"`,
+		"field mask: field_mask_disallow path nope is not in pb.Bad": `field_mask_of: "pb.Bad", field_mask_disallow: "nope"`,
+		"field mask: field_mask_disallow needs field_mask_of":        `field_mask_disallow: "mask"`,
+	} {
+		msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "google/protobuf/field_mask.proto";
+import "validation.proto";
+message Bad {
+  google.protobuf.FieldMask mask = 1 [(validation.field) = {` + rules + `}];
+}
+`})
+		if !strings.Contains(msg, want) {
+			t.Errorf("unexpected error: %s", msg)
+		}
+	}
+}
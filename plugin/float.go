@@ -10,17 +10,17 @@ import (
 func (p *Plugin) generateFloatValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
 	if v.FloatEq != nil {
 		p.P(`if %s != %f {`, fieldValue, v.GetFloatEq())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%f", v.GetFloatEq()), "{field} must equal {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "float.eq", fmt.Sprintf("%f", v.GetFloatEq()), fieldValue, "{field} must equal {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.FloatLte != nil {
 		p.P(`if %s > %f {`, fieldValue, v.GetFloatLte())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%f", v.GetFloatLte()), "{field} must be less than or equal to {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "float.lte", fmt.Sprintf("%f", v.GetFloatLte()), fieldValue, "{field} must be less than or equal to {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.FloatGte != nil {
 		p.P(`if %s < %f {`, fieldValue, v.GetFloatGte())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%f", v.GetFloatGte()), "{field} must be greater than or equal to {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "float.gte", fmt.Sprintf("%f", v.GetFloatGte()), fieldValue, "{field} must be greater than or equal to {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 }
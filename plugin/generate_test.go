@@ -0,0 +1,348 @@
+package plugin
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pluginpb "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+)
+
+// the generated code tests run the protos in testdata/<name> through gogofast and our plugin, then build and run the
+// main.go next to them which prints what Validate returned for some messages.  That has to match want.txt, run the
+// tests with -update to rewrite it after checking the output is right
+var update = flag.Bool("update", false, "rewrite want.txt for the generated code tests")
+
+// modulePath is where the generated code ends up as far as go is concerned, plugin/_gentest/<name>.  go ignores
+// directories starting with _ for ./... but they can still be built and imported
+const modulePath = "github.com/neophenix/protoc-gen-validation"
+
+// protoPackages is where the protos that aren't part of a test come from, the same as they would be passed to protoc
+var protoPackages = []string{
+	"Mvalidation.proto=" + modulePath,
+	"Mgoogle/protobuf/descriptor.proto=github.com/gogo/protobuf/protoc-gen-gogo/descriptor",
+	"Mgogoproto/gogo.proto=github.com/gogo/protobuf/gogoproto",
+	"Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types",
+	"Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types",
+	"Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types",
+	"Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types",
+	"Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types",
+	"Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types",
+}
+
+// showSource is added to every test's main package, show prints the whole error tree so want.txt has the rule and
+// path of every error
+const showSource = `package main
+
+import (
+	"fmt"
+
+	"github.com/neophenix/protoc-gen-validation/runtime"
+)
+
+func show(name string, err error) {
+	if err == nil {
+		fmt.Printf("%s: ok\n", name)
+		return
+	}
+	verr, ok := err.(*runtime.ValidationErrors)
+	if !ok {
+		fmt.Printf("%s: %T %s\n", name, err, err)
+		return
+	}
+	showErrors(name, "  ", verr.Errors)
+}
+
+func showErrors(name string, indent string, errs []*runtime.ValidationError) {
+	for _, e := range errs {
+		fmt.Printf("%s:%s%s %s %s: %s", name, indent, e.Field, e.Path, e.Rule, e.ErrorMessage)
+		if e.Actual != nil {
+			fmt.Printf(" (%v)", e.Actual)
+		}
+		fmt.Println()
+		showErrors(name, indent+"  ", e.Errors)
+	}
+}
+`
+
+var plugins struct {
+	once sync.Once
+	dir  string
+	err  error
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	code := m.Run()
+	if plugins.dir != "" {
+		os.RemoveAll(plugins.dir)
+	}
+	os.Exit(code)
+}
+
+// buildPlugins builds gogofast and our plugin once for all the tests, they are run as protoc would run them
+func buildPlugins(t *testing.T) string {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is needed to build the generated code")
+	}
+	plugins.once.Do(func() {
+		plugins.dir, plugins.err = ioutil.TempDir("", "protoc-gen-validation")
+		if plugins.err != nil {
+			return
+		}
+		for name, pkg := range map[string]string{
+			"protoc-gen-gogofast":   "github.com/gogo/protobuf/protoc-gen-gogofast",
+			"protoc-gen-validation": "../cmd/protoc-gen-validation",
+		} {
+			if out, err := exec.Command("go", "build", "-o", filepath.Join(plugins.dir, name), pkg).CombinedOutput(); err != nil {
+				plugins.err = fmt.Errorf("building %s: %s\n%s", name, err, out)
+				return
+			}
+		}
+	})
+	if plugins.err != nil {
+		t.Fatal(plugins.err)
+	}
+	return plugins.dir
+}
+
+// testGenerated generates the code for testdata/<name>, runs its main.go and compares the output with want.txt
+func testGenerated(t *testing.T, name string, param ...string) {
+	caseDir := filepath.Join("testdata", name)
+	files := map[string]string{}
+	err := filepath.Walk(caseDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(caseDir, path)
+		files[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := ioutil.ReadFile(filepath.Join(caseDir, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join("_gentest", name)
+	if err := os.RemoveAll(out); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("_gentest")
+	if msg := generate(t, out, files, param...); msg != "" {
+		t.Fatalf("generating %s: %s", name, msg)
+	}
+	for file, src := range map[string][]byte{"main.go": main, "show.go": []byte(showSource)} {
+		if err := ioutil.WriteFile(filepath.Join(out, file), src, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	trackRuntime(t)
+	pkgs := "./" + filepath.ToSlash(out) + "/..."
+	if output, err := exec.Command("go", "vet", pkgs).CombinedOutput(); err != nil {
+		t.Fatalf("vetting the generated code: %s\n%s", err, output)
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", "run", "./"+filepath.ToSlash(out))
+	cmd.Stderr = &stderr
+	got, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("running %s: %s\n%s", name, err, stderr.String())
+	}
+
+	wantFile := filepath.Join(caseDir, "want.txt")
+	if *update {
+		if err := ioutil.WriteFile(wantFile, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(wantFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s printed\n%s\nexpected\n%s", name, got, want)
+	}
+}
+
+// generationError runs the protos through our plugin expecting it to fail, and returns what it failed with
+func generationError(t *testing.T, files map[string]string, param ...string) string {
+	out := filepath.Join("_gentest", "fail")
+	defer os.RemoveAll("_gentest")
+	msg := generate(t, out, files, param...)
+	if msg == "" {
+		t.Fatal("expected generation to fail")
+	}
+	return msg
+}
+
+// generate writes the code for the protos in files, which maps their names to their source, to dir.  Each directory
+// the protos are in becomes a go package, so they can't be at the top.  What our plugin failed with is returned, gogofast
+// failing or anything else going wrong fails the test
+func generate(t *testing.T, dir string, files map[string]string, param ...string) string {
+	pluginDir := buildPlugins(t)
+
+	names := []string{}
+	for name := range files {
+		if !strings.Contains(name, "/") {
+			t.Fatalf("%s: test protos have to be in a directory for their go package", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := append([]string{"paths=source_relative"}, protoPackages...)
+	packages := map[string][]string{}
+	for _, name := range names {
+		pkg := filepath.Dir(name)
+		params = append(params, fmt.Sprintf("M%s=%s/plugin/%s", name, modulePath, filepath.ToSlash(filepath.Join(dir, pkg))))
+		packages[pkg] = append(packages[pkg], name)
+	}
+	protos := parseProtos(t, files, names)
+
+	// like protoc, the plugins are run once per go package
+	pkgs := []string{}
+	for pkg := range packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for _, pkg := range pkgs {
+		req := &pluginpb.CodeGeneratorRequest{
+			FileToGenerate: packages[pkg],
+			Parameter:      proto.String(strings.Join(append(params, param...), ",")),
+			ProtoFile:      protos,
+		}
+		for _, name := range []string{"protoc-gen-gogofast", "protoc-gen-validation"} {
+			resp, msg := runPlugin(t, filepath.Join(pluginDir, name), req)
+			if msg != "" {
+				if name == "protoc-gen-validation" {
+					return msg
+				}
+				t.Fatalf("%s: %s", name, msg)
+			}
+			for _, file := range resp.File {
+				path := filepath.Join(dir, file.GetName())
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(path, []byte(file.GetContent()), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// parseProtos does what protoc would, validation.proto comes from the root of the repo and gogo.proto from the gogo
+// module.  Dependencies have to come before the files that import them
+func parseProtos(t *testing.T, files map[string]string, names []string) []*descriptor.FileDescriptorProto {
+	gogoDir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/gogo/protobuf").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := protoparse.Parser{
+		IncludeSourceCodeInfo: true,
+		Accessor: func(name string) (io.ReadCloser, error) {
+			if src, ok := files[name]; ok {
+				return ioutil.NopCloser(strings.NewReader(src)), nil
+			}
+			for _, dir := range []string{"..", strings.TrimSpace(string(gogoDir))} {
+				if f, err := os.Open(filepath.Join(dir, name)); err == nil {
+					return f, nil
+				}
+			}
+			return nil, os.ErrNotExist
+		},
+	}
+	fds, err := parser.ParseFiles(names...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	protos := []*descriptor.FileDescriptorProto{}
+	seen := map[string]bool{}
+	var add func(fd *desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependencies() {
+			add(dep)
+		}
+		// protoparse gives us github.com/golang/protobuf descriptors, the wire format is the same as gogo's
+		b, err := proto.Marshal(fd.AsFileDescriptorProto())
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := &descriptor.FileDescriptorProto{}
+		if err := proto.Unmarshal(b, file); err != nil {
+			t.Fatal(err)
+		}
+		protos = append(protos, file)
+	}
+	for _, fd := range fds {
+		add(fd)
+	}
+	return protos
+}
+
+// runPlugin runs a plugin the way protoc does, what the plugin failed with comes back as the message
+func runPlugin(t *testing.T, path string, req *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, string) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(path)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, strings.TrimSpace(stderr.String())
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		return nil, resp.GetError()
+	}
+	return resp, ""
+}
+
+// trackRuntime reads the runtime package.  go test only knows to rerun a cached test when a file the test read
+// changes, and it is go run that reads the runtime package when it builds the generated code
+func trackRuntime(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "runtime", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if _, err := ioutil.ReadFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
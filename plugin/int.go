@@ -10,17 +10,17 @@ import (
 func (p *Plugin) generateIntValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
 	if v.IntEq != nil {
 		p.P(`if %s != %d {`, fieldValue, v.GetIntEq())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetIntEq()), "{field} must equal {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "int.eq", fmt.Sprintf("%d", v.GetIntEq()), fieldValue, "{field} must equal {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.IntLte != nil {
 		p.P(`if %s > %d {`, fieldValue, v.GetIntLte())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetIntLte()), "{field} must be less than or equal to {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "int.lte", fmt.Sprintf("%d", v.GetIntLte()), fieldValue, "{field} must be less than or equal to {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.IntGte != nil {
 		p.P(`if %s < %d {`, fieldValue, v.GetIntGte())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetIntGte()), "{field} must be greater than or equal to {value}", v, mv, field, "")
+		p.generateErrorCode(fieldName, "int.gte", fmt.Sprintf("%d", v.GetIntGte()), fieldValue, "{field} must be greater than or equal to {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 }
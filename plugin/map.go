@@ -0,0 +1,116 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+func (p *Plugin) generateMapValidationCode(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	fieldName := field.GetName()
+	if !onlyMapRules(getFieldValidation(field)) {
+		p.gen.Fail(fmt.Sprintf("field %s: maps only take map_min_pairs and map_max_pairs, rules for the keys and values go in map_key and map_value", fieldName))
+	}
+
+	var keyRules, valueRules *pb.FieldValidation
+	if v != nil {
+		if v.MapMinPairs != nil {
+			p.P(`if len(%s) < %d {`, fieldAccessor, v.GetMapMinPairs())
+			p.generateFieldErrorCode(fieldName, jsonName(field), "", "map.min_pairs", fmt.Sprintf("%d", v.GetMapMinPairs()), "len("+fieldAccessor+")", "{field} must have at least {value} pairs", v, mv, "")
+			p.P(`}`)
+		}
+		if v.MapMaxPairs != nil {
+			p.P(`if len(%s) > %d {`, fieldAccessor, v.GetMapMaxPairs())
+			p.generateFieldErrorCode(fieldName, jsonName(field), "", "map.max_pairs", fmt.Sprintf("%d", v.GetMapMaxPairs()), "len("+fieldAccessor+")", "{field} must have no more than {value} pairs", v, mv, "")
+			p.P(`}`)
+		}
+		if len(v.GetMapKey().GetCel()) != 0 || len(v.GetMapValue().GetCel()) != 0 {
+			p.gen.Fail(fmt.Sprintf("field %s: cel rules go on the map field itself, not map_key or map_value", fieldName))
+		}
+		if len(fieldComparisons(v.GetMapKey())) != 0 || len(fieldComparisons(v.GetMapValue())) != 0 {
+			p.gen.Fail(fmt.Sprintf("field %s: map keys and values can not be compared to other fields", fieldName))
+		}
+		for _, rules := range []*pb.FieldValidation{v.GetMapKey(), v.GetMapValue()} {
+			if len(rules.GetRequiredIf()) != 0 || len(rules.GetRequiredUnless()) != 0 || len(rules.GetForbiddenIf()) != 0 {
+				p.gen.Fail(fmt.Sprintf("field %s: conditions go on the map field itself, not map_key or map_value", fieldName))
+			}
+		}
+		keyRules = inlineRules(v.MapKey)
+		valueRules = inlineRules(v.MapValue)
+	}
+
+	// GoMapType would be the obvious choice here, but it marks the value's package as used and we don't need the import
+	entry := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+	keyField, valueField := entry.Field[0], entry.Field[1]
+	validateMessages := valueField.IsMessage() && !isWKT(valueField.GetTypeName())
+	if keyRules == nil && valueRules == nil && !validateMessages {
+		return
+	}
+
+	// errors for keys and values are reported as field[key] so we just need k, values are accessed through the map.  Their
+	// rules are prefixed with map.key. and map.value. so the two can be told apart
+	loopStart := p.gen.Len()
+	p.P("for k := range %s {", fieldAccessor)
+	bodyStart := p.gen.Len()
+	valueAccessor := fieldAccessor + "[k]"
+	if validateMessages {
+		// the message may live in a file we didn't generate for, so only call Validate if it exists
+		p.P("if validator, ok := interface{}(%s).(%s.Validator); ok {", valueAccessor, p.runtimePkg.Use())
+		p.P("msgerr := validator.Validate()")
+		p.P("if msgerr != nil {")
+		p.P("if msgvalerr, ok := msgerr.(*%s.ValidationErrors); ok {", p.runtimePkg.Use())
+		p.generateErrorCode(fieldName, "message.nested", "", "", "error in {field}", valueRules, mv, field, "msgvalerr")
+		p.P("}")
+		p.P("}")
+		p.P("}")
+	} else if valueRules != nil {
+		p.rulePrefix = "map.value."
+		if valueField.IsMessage() {
+			p.P("if %s != nil {", valueAccessor)
+			if isWKTWrapper(valueField.GetTypeName()) {
+				p.generateValueValidationCode(fieldName, valueAccessor+".Value", valueField, valueRules, mv, field)
+			} else {
+				p.generateValueValidationCode(fieldName, valueAccessor, valueField, valueRules, mv, field)
+			}
+			p.P("}")
+		} else {
+			p.generateValueValidationCode(fieldName, valueAccessor, valueField, valueRules, mv, field)
+		}
+	}
+	if keyRules != nil {
+		p.rulePrefix = "map.key."
+		p.generateValueValidationCode(fieldName, "k", keyField, keyRules, mv, field)
+	}
+	p.rulePrefix = ""
+	if !p.dropIfEmpty(loopStart, bodyStart) {
+		p.P("}")
+	}
+}
+
+// onlyMapRules is false if v has rules that don't apply to a map as a whole, they would otherwise be silently ignored.
+// cel, conditions and comparisons are checked where they are generated
+func onlyMapRules(v *pb.FieldValidation) bool {
+	if v == nil {
+		return true
+	}
+	rules := proto.Clone(v).(*pb.FieldValidation)
+	rules.MapMinPairs = nil
+	rules.MapMaxPairs = nil
+	rules.MapKey = nil
+	rules.MapValue = nil
+	rules.Error = nil
+	rules.Cel = nil
+	rules.RequiredIf = nil
+	rules.RequiredUnless = nil
+	rules.ForbiddenIf = nil
+	rules.EqField = nil
+	rules.NeField = nil
+	rules.GtField = nil
+	rules.GteField = nil
+	rules.LtField = nil
+	rules.LteField = nil
+	return proto.Equal(rules, &pb.FieldValidation{})
+}
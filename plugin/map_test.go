@@ -0,0 +1,23 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	testGenerated(t, "maps")
+}
+
+func TestMapRulesOnTheMap(t *testing.T) {
+	msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "validation.proto";
+message Bad {
+  map<string, string> labels = 1 [(validation.field).min_len = 1];
+}
+`})
+	if !strings.Contains(msg, "field labels: maps only take map_min_pairs and map_max_pairs") {
+		t.Errorf("unexpected error: %s", msg)
+	}
+}
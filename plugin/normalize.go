@@ -0,0 +1,228 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// generateNormalize outputs Normalize for the message, this is where all the transforms (trim, lc, uc, trim_strings and
+// transform_func) happen so Validate never has to modify the message.  Only messages that have transforms, or contain a
+// message that does, get one
+func (p *Plugin) generateNormalize(message *generator.Descriptor) {
+	if !p.needsNormalize(message) {
+		return
+	}
+	for _, field := range message.Field {
+		if generator.CamelCase(field.GetName()) == "Normalize" {
+			p.gen.Fail(fmt.Sprintf("message %s: field %s clashes with the generated Normalize method, it needs a different name", message.GetName(), field.GetName()))
+		}
+	}
+	for _, oneof := range message.OneofDecl {
+		if generator.CamelCase(oneof.GetName()) == "Normalize" {
+			p.gen.Fail(fmt.Sprintf("message %s: oneof %s clashes with the generated Normalize method, it needs a different name", message.GetName(), oneof.GetName()))
+		}
+	}
+	mv := getMessageValidation(message)
+
+	p.P("func (m *%s) Normalize() {", generator.CamelCaseSlice(message.TypeName()))
+	p.P("if m == nil {")
+	p.P("return")
+	p.P("}")
+
+	oneofs := map[int32]bool{}
+	for _, field := range message.Field {
+		if field.OneofIndex != nil {
+			if !oneofs[field.GetOneofIndex()] {
+				oneofs[field.GetOneofIndex()] = true
+				p.generateOneofNormalizeCode(message, field.GetOneofIndex(), mv)
+			}
+			continue
+		}
+
+		v := getFieldValidation(field)
+		if v != nil && v.DoNotValidate != nil {
+			continue
+		}
+		p.generateFieldNormalizeCode(field, "m."+generator.CamelCase(field.GetName()), v, mv)
+	}
+	p.P("}")
+}
+
+func (p *Plugin) generateFieldNormalizeCode(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	if p.gen.IsMap(field) {
+		p.generateMapNormalizeCode(field, fieldAccessor, v, mv)
+		return
+	}
+
+	if field.IsMessage() && !isWKT(field.GetTypeName()) {
+		if !p.needsNormalize(p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)) {
+			return
+		}
+		if field.IsRepeated() {
+			p.P("for _, v := range %s {", fieldAccessor)
+			p.generateNormalizeCall("v")
+			p.P("}")
+		} else {
+			p.generateNormalizeCall(fieldAccessor)
+		}
+		return
+	}
+
+	if !hasTransforms(field, v, mv) {
+		return
+	}
+
+	valueAccessor := fieldAccessor
+	if field.IsRepeated() {
+		p.P("for i := range %s {", fieldAccessor)
+		valueAccessor = fieldAccessor + "[i]"
+	}
+	if isWKTWrapper(field.GetTypeName()) {
+		p.P("if %s != nil {", valueAccessor)
+		p.generateTransformCode(valueAccessor+".Value", field, v, mv)
+		p.P("}")
+	} else if p.isPointerScalar(field) {
+		p.P("if %s != nil {", valueAccessor)
+		p.generateTransformCode("*"+valueAccessor, field, v, mv)
+		p.P("}")
+	} else {
+		p.generateTransformCode(valueAccessor, field, v, mv)
+	}
+	if field.IsRepeated() {
+		p.P("}")
+	}
+}
+
+// generateMapNormalizeCode only looks at values, keys can't be changed in place
+func (p *Plugin) generateMapNormalizeCode(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	entry := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+	valueField := entry.Field[1]
+
+	if valueField.IsMessage() && !isWKT(valueField.GetTypeName()) {
+		if !p.needsNormalize(p.gen.ObjectNamed(valueField.GetTypeName()).(*generator.Descriptor)) {
+			return
+		}
+		p.P("for _, v := range %s {", fieldAccessor)
+		p.generateNormalizeCall("v")
+		p.P("}")
+		return
+	}
+
+	valueRules := v.GetMapValue()
+	if !hasTransforms(valueField, valueRules, mv) {
+		return
+	}
+
+	p.P("for k := range %s {", fieldAccessor)
+	valueAccessor := fieldAccessor + "[k]"
+	if isWKTWrapper(valueField.GetTypeName()) {
+		p.P("if %s != nil {", valueAccessor)
+		p.generateTransformCode(valueAccessor+".Value", valueField, valueRules, mv)
+		p.P("}")
+	} else {
+		p.generateTransformCode(valueAccessor, valueField, valueRules, mv)
+	}
+	p.P("}")
+}
+
+// generateOneofNormalizeCode switches on the oneof the same way validation does, so only the case that is set is touched
+func (p *Plugin) generateOneofNormalizeCode(message *generator.Descriptor, index int32, mv *pb.MessageValidation) {
+	fields := []*descriptor.FieldDescriptorProto{}
+	for _, field := range message.Field {
+		if field.OneofIndex == nil || field.GetOneofIndex() != index {
+			continue
+		}
+		v := getFieldValidation(field)
+		if v != nil && v.DoNotValidate != nil {
+			continue
+		}
+		if p.fieldNeedsNormalize(field, v, mv, map[*generator.Descriptor]bool{}) {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	p.P("switch o := m.%s.(type) {", generator.CamelCase(message.OneofDecl[index].GetName()))
+	for _, field := range fields {
+		p.P("case *%s:", oneofTypeName(message, field))
+		p.generateFieldNormalizeCode(field, "o."+generator.CamelCase(field.GetName()), getFieldValidation(field), mv)
+	}
+	p.P("}")
+}
+
+// generateNormalizeCall calls Normalize on a nested message, which may live in a file we didn't generate for
+func (p *Plugin) generateNormalizeCall(accessor string) {
+	p.P("if normalizer, ok := interface{}(%s).(%s.Normalizer); ok {", accessor, p.runtimePkg.Use())
+	p.P("normalizer.Normalize()")
+	p.P("}")
+}
+
+// generateTransformCode writes the transforms for a single value back to it, transform_func goes first so the string
+// transforms see its result
+func (p *Plugin) generateTransformCode(fieldValue string, valueField *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	if v.GetTransformFunc() != "" {
+		p.P("%s = %s(%s)", fieldValue, v.GetTransformFunc(), fieldValue)
+	}
+	if !isString(valueField) {
+		return
+	}
+	if v.GetTrim() || mv.GetTrimStrings() {
+		p.P(`%s = %s.Trim(%s, " ")`, fieldValue, p.stringsPkg.Use(), fieldValue)
+	}
+	if v.GetLc() {
+		p.P(`%s = %s.ToLower(%s)`, fieldValue, p.stringsPkg.Use(), fieldValue)
+	}
+	if v.GetUc() {
+		p.P(`%s = %s.ToUpper(%s)`, fieldValue, p.stringsPkg.Use(), fieldValue)
+	}
+}
+
+// needsNormalize is true when the message, or any message in it, has a transform
+func (p *Plugin) needsNormalize(message *generator.Descriptor) bool {
+	return p.hasNormalizeWork(message, map[*generator.Descriptor]bool{})
+}
+
+// hasNormalizeWork does the work for needsNormalize, seen is every message we already looked at so a message that
+// contains itself doesn't send us round in circles
+func (p *Plugin) hasNormalizeWork(message *generator.Descriptor, seen map[*generator.Descriptor]bool) bool {
+	if seen[message] {
+		return false
+	}
+	seen[message] = true
+	mv := getMessageValidation(message)
+	for _, field := range message.Field {
+		v := getFieldValidation(field)
+		if v != nil && v.DoNotValidate != nil {
+			continue
+		}
+		if p.fieldNeedsNormalize(field, v, mv, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldNeedsNormalize is true when Normalize has something to do for the field, either its own transforms or a
+// message in it that has some.  For maps only the values count, keys are never transformed
+func (p *Plugin) fieldNeedsNormalize(field *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation, seen map[*generator.Descriptor]bool) bool {
+	if p.gen.IsMap(field) {
+		field = p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor).Field[1]
+		v = v.GetMapValue()
+	}
+	if field.IsMessage() && !isWKT(field.GetTypeName()) {
+		return p.hasNormalizeWork(p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor), seen)
+	}
+	return hasTransforms(field, v, mv)
+}
+
+func hasTransforms(valueField *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation) bool {
+	if v.GetTransformFunc() != "" {
+		return true
+	}
+	return isString(valueField) && (v.GetTrim() || v.GetLc() || v.GetUc() || mv.GetTrimStrings())
+}
@@ -0,0 +1,23 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	testGenerated(t, "normalize")
+}
+
+func TestNormalizeFieldClash(t *testing.T) {
+	msg := generationError(t, map[string]string{"pb/clash.proto": `syntax = "proto3";
+package pb;
+import "validation.proto";
+message Clash {
+  string normalize = 1 [(validation.field).trim = true];
+}
+`})
+	if !strings.Contains(msg, "message Clash: field normalize clashes with the generated Normalize method") {
+		t.Errorf("unexpected error: %s", msg)
+	}
+}
@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// generateOneofValidationCode switches on the type of the oneof wrapper so only the case that is set is validated, using
+// the rules of that case's field
+func (p *Plugin) generateOneofValidationCode(message *generator.Descriptor, index int32, mv *pb.MessageValidation) {
+	oneof := message.OneofDecl[index]
+	ov := getOneofValidation(oneof)
+	required := ov != nil && ov.GetRequired()
+
+	// only cases with something to check get generated, messages always do since we call Validate on them
+	fields := []*descriptor.FieldDescriptorProto{}
+	for _, field := range message.Field {
+		if field.OneofIndex == nil || field.GetOneofIndex() != index {
+			continue
+		}
+		v := getFieldValidation(field)
+		if v != nil && v.DoNotValidate != nil {
+			continue
+		}
+		if inlineRules(v) == nil && (!field.IsMessage() || isWKT(field.GetTypeName())) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	oneofAccessor := "m." + generator.CamelCase(oneof.GetName())
+	switchStart := p.gen.Len()
+	p.P("switch o := %s.(type) {", oneofAccessor)
+	cases := 0
+	for _, field := range fields {
+		caseStart := p.gen.Len()
+		p.P("case *%s:", oneofTypeName(message, field))
+		bodyStart := p.gen.Len()
+		p.generateFieldValidation(field, "o."+generator.CamelCase(field.GetName()), inlineRules(getFieldValidation(field)), mv)
+		if !p.dropIfEmpty(caseStart, bodyStart) {
+			cases++
+		}
+	}
+
+	// with no cases left o would be unused, so the switch goes too
+	if cases == 0 {
+		p.gen.Truncate(switchStart)
+		if required {
+			p.P("if %s == nil {", oneofAccessor)
+			p.generateFieldErrorCode(oneof.GetName(), lowerCamelCase(oneof.GetName()), "", "oneof.required", "", "", "{field} is required", nil, mv, "")
+			p.P("}")
+		}
+		return
+	}
+	if required {
+		p.P("case nil:")
+		p.generateFieldErrorCode(oneof.GetName(), lowerCamelCase(oneof.GetName()), "", "oneof.required", "", "", "{field} is required", nil, mv, "")
+	}
+	p.P("}")
+}
+
+// oneofTypeName is the same as generator.OneOfTypeName, which we can't use since it marks the package of the field's
+// type as used and we would end up with an unused import
+func oneofTypeName(message *generator.Descriptor, field *descriptor.FieldDescriptorProto) string {
+	tname := generator.CamelCaseSlice(message.TypeName()) + "_" + generator.CamelCase(field.GetName())
+	// It is possible for this to collide with a message or enum nested in this message
+	for _, desc := range message.File().Messages() {
+		if strings.Join(desc.TypeName(), "_") == tname {
+			return tname + "_"
+		}
+	}
+	for _, enum := range message.File().Enums() {
+		if strings.Join(enum.TypeName(), "_") == tname {
+			return tname + "_"
+		}
+	}
+	return tname
+}
@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestOneof(t *testing.T) {
+	testGenerated(t, "oneofs")
+}
@@ -2,7 +2,9 @@ package plugin
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
 
 	pb "github.com/neophenix/protoc-gen-validation"
 
@@ -10,17 +12,34 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	"github.com/google/cel-go/cel"
 )
 
+// runtimePath is the package the generated code imports for the error types and helper funcs
+const runtimePath = "github.com/neophenix/protoc-gen-validation/runtime"
+
+// validateAllParam is the plugin parameter that forces Validate funcs for every message, i.e.
+// --validation_out=validate_all=true:.
+const validateAllParam = "validate_all"
+
 type Plugin struct {
 	gen        *generator.Generator
 	imp        generator.PluginImports
 	regexPkg   generator.Single
 	stringsPkg generator.Single
-	mailPkg    generator.Single
-	timePkg    generator.Single
-	uuidPkg    generator.Single
+	bytesPkg   generator.Single
 	strconvPkg generator.Single
+	fmtPkg     generator.Single
+	timePkg    generator.Single
+	utf8Pkg    generator.Single
+	runtimePkg generator.Single
+	// syntax of the file we are currently generating
+	proto3 bool
+	// regex patterns used in the file we are currently generating
+	regexes *regexVars
+	celEnv  *cel.Env
+	// put in front of the rule of every error while it is set, map keys and values use it to tell their errors apart
+	rulePrefix string
 }
 
 func New() generator.Plugin {
@@ -33,109 +52,150 @@ func (p *Plugin) Name() string {
 
 func (p *Plugin) Init(g *generator.Generator) {
 	p.gen = g
-	p.imp = generator.NewPluginImports(p.gen)
 }
 
 // Here is where all the work is done, this is called to generate the main guts of the code
 func (p *Plugin) Generate(file *generator.FileDescriptor) {
-	if len(file.FileDescriptorProto.Service) == 0 {
+	// Generate is called for every file protoc gives us, including the ones only there as imports, so each file starts
+	// out with its own imports or it would get the ones used by the files before it
+	p.imp = generator.NewPluginImports(p.gen)
+	if !p.shouldGenerate(file) {
 		return
 	}
 
-	// output our error type
-	p.generateErrorType()
-
 	p.regexPkg = p.imp.NewImport("regexp")
 	p.stringsPkg = p.imp.NewImport("strings")
-	p.mailPkg = p.imp.NewImport("net/mail")
-	p.timePkg = p.imp.NewImport("time")
-	p.uuidPkg = p.imp.NewImport("github.com/google/uuid")
+	p.bytesPkg = p.imp.NewImport("bytes")
 	p.strconvPkg = p.imp.NewImport("strconv")
+	p.fmtPkg = p.imp.NewImport("fmt")
+	p.timePkg = p.imp.NewImport("time")
+	p.utf8Pkg = p.imp.NewImport("unicode/utf8")
+	p.runtimePkg = p.imp.NewImport(runtimePath)
+	p.proto3 = gogoproto.IsProto3(file.FileDescriptorProto)
+	p.regexes = newRegexVars(file)
 	for _, msg := range file.Messages() {
 		if msg.DescriptorProto.GetOptions().GetMapEntry() {
 			continue
 		}
 
-		if gogoproto.IsProto3(file.FileDescriptorProto) {
-			p.generateProto3(file, msg)
-		}
+		p.generateMessageValidation(msg)
+		p.generateNormalize(msg)
 	}
 
-	// Helper funcs we can just generate even if we don't use them
-	p.generateHelperFunctions()
+	p.generateRegexVars()
 }
 
 // Remember that this is called last, so that we can mark imports as used in Generate and then they get output here.
 // So don't go adding things here that expect to be first
 func (p *Plugin) GenerateImports(file *generator.FileDescriptor) {
-	if len(file.FileDescriptorProto.Service) == 0 {
+	if !p.shouldGenerate(file) {
 		return
 	}
 	p.imp.GenerateImports(file)
 }
 
-// I lied above, this is actaully where all the code gets generated, at least for proto3
-func (p *Plugin) generateProto3(file *generator.FileDescriptor, message *generator.Descriptor) {
-	// begin Validate for this message
-	p.P("func (m *%s) Validate() error {", message.GetName())
-	p.P("err := ValidationErrors{Errors: []*ValidationError{}}")
-	// if the message is nil, we can't validate it.  This should be ok to do here and will only be for "top level" messages
-	// any embedded messages we already check to make sure they aren't nil before we call Validate on them down below
-	p.P("if m == nil {")
-	p.P(`err.Errors = []*ValidationError{&ValidationError{Field: "message", ErrorMessage: "message is nil, validation can not proceed"}}`)
-	p.P(`return &err`)
-	p.P("}")
+// shouldGenerate decides if this file gets Validate funcs at all.  Files that define services always do, otherwise we
+// only bother if some message in the file uses our options, or if validate_all=true was passed as a plugin parameter
+func (p *Plugin) shouldGenerate(file *generator.FileDescriptor) bool {
+	if p.gen.Param[validateAllParam] == "true" {
+		return true
+	}
+	if len(file.FileDescriptorProto.Service) != 0 {
+		return true
+	}
+	for _, msg := range file.Messages() {
+		if getMessageValidation(msg) != nil {
+			return true
+		}
+		for _, oneof := range msg.OneofDecl {
+			if getOneofValidation(oneof) != nil {
+				return true
+			}
+		}
+		for _, field := range msg.Field {
+			if getFieldValidation(field) != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// I lied above, this is actaully where all the code gets generated.  proto2 is mostly the same as proto3, except that
+// optional and required scalars are pointers so we need to check they are set before we can look at the value
+func (p *Plugin) generateMessageValidation(message *generator.Descriptor) {
+	p.generateValidateStart(message)
 
 	mv := getMessageValidation(message)
 
+	oneofs := map[int32]bool{}
 	for _, field := range message.Field {
+		// oneofs are validated as a whole the first time we see one of their fields
+		if field.OneofIndex != nil {
+			if !oneofs[field.GetOneofIndex()] {
+				oneofs[field.GetOneofIndex()] = true
+				p.generateOneofValidationCode(message, field.GetOneofIndex(), mv)
+			}
+			continue
+		}
+
 		v := getFieldValidation(field)
 
 		// Do not validate if this is set
 		if v != nil && v.DoNotValidate != nil {
 			continue
 		}
+		// transforms are left to Normalize, conditions, comparisons and cel rules to the end
+		v = inlineRules(v)
 
-		if field.IsMessage() {
-			if isWKT(field.GetTypeName()) {
-				if v != nil {
-					p.P("if m.%s != nil {", generator.CamelCase(field.GetName()))
-					p.generateValidationCode(field, v, mv)
-					p.P("}")
-				}
-			} else if p.gen.IsMap(field) {
-				p.P("// field:[%s] - maps not supported yet", field.GetName())
-			} else {
-				if field.IsRepeated() {
-					p.P("for i, v := range m.%s {", generator.CamelCase(field.GetName()))
-					p.P("msgerr := v.Validate()")
-					p.P("if msgerr != nil {")
-					p.P("if msgvalerr, ok := msgerr.(*ValidationErrors); ok {")
-					p.generateErrorCode(generator.CamelCase(field.GetName()), "", "error in repeated value {field}", v, mv, field, "msgvalerr")
-					p.P("}")
-					p.P("}")
-					p.P("}")
-				} else {
-					p.P("if m.%s != nil { ", generator.CamelCase(field.GetName()))
-					p.P("msgerr := m.%s.Validate()", generator.CamelCase(field.GetName()))
-					p.P("if msgerr != nil {")
-					p.P("if msgvalerr, ok := msgerr.(*ValidationErrors); ok {")
-					p.generateErrorCode(generator.CamelCase(field.GetName()), "", "error in {field}", v, mv, field, "msgvalerr")
-					p.P("}")
-					p.P("}")
-					p.P("}")
-				}
-			}
-		} else {
-			if field.IsRepeated() && v != nil {
-				p.P("for i, _ := range m.%s {", generator.CamelCase(field.GetName()))
-				p.generateValidationCode(field, v, mv)
-				p.P("}")
-			} else {
-				p.generateValidationCode(field, v, mv)
+		fieldAccessor := "m." + generator.CamelCase(field.GetName())
+		if !p.isPointerScalar(field) && !(field.IsRequired() && field.IsBytes()) {
+			// required messages are handled by the same nil check as the required option
+			p.generateFieldValidation(field, fieldAccessor, v, mv)
+			continue
+		}
+
+		if field.IsRequired() {
+			// unmarshalling will already fail without required fields, but a message built in code can still skip them
+			p.P("if %s == nil {", fieldAccessor)
+			p.generateErrorCode(field.GetName(), "field.required", "", "", "{field} is required", v, mv, field, "")
+			if v != nil {
+				p.P("} else {")
+				p.generateValidationCode(field, fieldAccessor, v, mv)
 			}
+			p.P("}")
+		} else if v != nil {
+			// unset optional fields are skipped entirely
+			p.P("if %s != nil {", fieldAccessor)
+			p.generateValidationCode(field, fieldAccessor, v, mv)
+			p.P("}")
 		}
 	}
+
+	p.generateConditionalCode(message, mv)
+	p.generateFieldComparisonCode(message, mv)
+	p.generateCELValidationCode(message, mv)
+	p.generateValidateEnd(mv)
+}
+
+// generateValidateStart opens the Validate func for this message and handles a nil message
+func (p *Plugin) generateValidateStart(message *generator.Descriptor) {
+	// begin Validate for this message
+	p.P("func (m *%s) Validate() error {", generator.CamelCaseSlice(message.TypeName()))
+	p.P("err := %s.ValidationErrors{Errors: []*%s.ValidationError{}}", p.runtimePkg.Use(), p.runtimePkg.Use())
+	// if the message is nil, we can't validate it.  This should be ok to do here and will only be for "top level" messages
+	// any embedded messages we already check to make sure they aren't nil before we call Validate on them down below
+	p.P("if m == nil {")
+	p.P(`err.Errors = []*%s.ValidationError{&%s.ValidationError{Field: "message", Path: "message", Rule: "message.nil", ErrorMessage: "message is nil, validation can not proceed"}}`, p.runtimePkg.Use(), p.runtimePkg.Use())
+	p.P(`return &err`)
+	p.P("}")
+	if getMessageValidation(message).GetNormalizeInValidate() && p.needsNormalize(message) {
+		p.P("m.Normalize()")
+	}
+}
+
+// generateValidateEnd returns any errors and closes the Validate func
+func (p *Plugin) generateValidateEnd(mv *pb.MessageValidation) {
 	// return any error and close Validate for this message
 	// but only return errors here if we aren't returning on individual errors as defined by message options
 	if mv == nil || mv.ReturnOnError == nil || !mv.GetReturnOnError() {
@@ -145,33 +205,170 @@ func (p *Plugin) generateProto3(file *generator.FileDescriptor, message *generat
 	p.P("}")
 }
 
+// generateFieldValidation outputs the validation for a single field, this is where we figure out what kind of field
+// we are dealing with
+func (p *Plugin) generateFieldValidation(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	if v != nil && v.GetRequired() && (!field.IsMessage() || field.IsRepeated()) {
+		p.gen.Fail(fmt.Sprintf("field %s: required only works on singular message fields", field.GetName()))
+	}
+	if field.IsRepeated() && !p.gen.IsMap(field) && v != nil {
+		p.generateRepeatedValidationCode(field, fieldAccessor, v, mv)
+	}
+
+	if field.IsMessage() {
+		if isWKT(field.GetTypeName()) {
+			if v != nil && field.IsRepeated() {
+				if hasItemRules(v) {
+					loopStart := p.gen.Len()
+					p.P("for i := range %s {", fieldAccessor)
+					p.P("if %s[i] != nil {", fieldAccessor)
+					bodyStart := p.gen.Len()
+					p.generateValidationCode(field, fieldAccessor, v, mv)
+					if !p.dropIfEmpty(loopStart, bodyStart) {
+						p.P("}")
+						p.P("}")
+					}
+				}
+			} else if v != nil || field.IsRequired() {
+				p.generateMessageNilCheck(field, fieldAccessor, v, mv)
+				p.generateValidationCode(field, fieldAccessor, v, mv)
+				p.P("}")
+			}
+		} else if p.gen.IsMap(field) {
+			p.generateMapValidationCode(field, fieldAccessor, v, mv)
+		} else {
+			if field.IsRepeated() {
+				p.P("for i, v := range %s {", fieldAccessor)
+				// the message may live in a file we didn't generate for, so only call Validate if it exists
+				p.P("if validator, ok := interface{}(v).(%s.Validator); ok {", p.runtimePkg.Use())
+				p.P("msgerr := validator.Validate()")
+				p.P("if msgerr != nil {")
+				p.P("if msgvalerr, ok := msgerr.(*%s.ValidationErrors); ok {", p.runtimePkg.Use())
+				p.generateErrorCode(field.GetName(), "message.nested", "", "", "error in repeated value {field}", v, mv, field, "msgvalerr")
+				p.P("}")
+				p.P("}")
+				p.P("}")
+				p.P("}")
+			} else {
+				p.generateMessageNilCheck(field, fieldAccessor, v, mv)
+				p.P("if validator, ok := interface{}(%s).(%s.Validator); ok {", fieldAccessor, p.runtimePkg.Use())
+				p.P("msgerr := validator.Validate()")
+				p.P("if msgerr != nil {")
+				p.P("if msgvalerr, ok := msgerr.(*%s.ValidationErrors); ok {", p.runtimePkg.Use())
+				p.generateErrorCode(field.GetName(), "message.nested", "", "", "error in {field}", v, mv, field, "msgvalerr")
+				p.P("}")
+				p.P("}")
+				p.P("}")
+				p.P("}")
+			}
+		}
+	} else {
+		if field.IsRepeated() && v != nil {
+			if hasItemRules(v) {
+				loopStart := p.gen.Len()
+				p.P("for i, _ := range %s {", fieldAccessor)
+				bodyStart := p.gen.Len()
+				p.generateValidationCode(field, fieldAccessor, v, mv)
+				if !p.dropIfEmpty(loopStart, bodyStart) {
+					p.P("}")
+				}
+			}
+		} else {
+			p.generateValidationCode(field, fieldAccessor, v, mv)
+		}
+	}
+}
+
+// generateMessageNilCheck opens the if we wrap message fields in so we don't use them when nil, if the field is required
+// being nil is an error, either with the required option or as a proto2 required field.  The caller closes the if
+func (p *Plugin) generateMessageNilCheck(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	// a BoolValue that has to be a certain value can't be left unset either
+	if field.IsRequired() || (v != nil && (v.GetRequired() || (v.BoolConst != nil && isWKTBool(field.GetTypeName())))) {
+		p.P("if %s == nil {", fieldAccessor)
+		p.generateErrorCode(field.GetName(), "message.required", "", "", "{field} is required", v, mv, field, "")
+		p.P("} else {")
+	} else {
+		p.P("if %s != nil {", fieldAccessor)
+	}
+}
+
+// isPointerScalar is true for proto2 optional / required scalars which gogo generates as pointers (unless nullable is
+// turned off), we need to nil check and dereference these
+func (p *Plugin) isPointerScalar(field *descriptor.FieldDescriptorProto) bool {
+	return pointerScalar(p.proto3, field)
+}
+
+// pointerScalar is isPointerScalar for a field of a message in any file, proto3 is the syntax of that file
+func pointerScalar(proto3 bool, field *descriptor.FieldDescriptorProto) bool {
+	if proto3 || field.IsRepeated() || field.IsMessage() || field.IsBytes() || field.OneofIndex != nil {
+		return false
+	}
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP {
+		return false
+	}
+	return gogoproto.IsNullable(field)
+}
+
+// dropIfEmpty removes everything generated since start if nothing was generated after bodyStart.  Rules that don't apply
+// to the field's type, or are switched off, generate nothing, which would leave the variable of the loop or type switch
+// around them unused and the generated code wouldn't compile
+func (p *Plugin) dropIfEmpty(start int, bodyStart int) bool {
+	if p.gen.Len() != bodyStart {
+		return false
+	}
+	p.gen.Truncate(start)
+	return true
+}
+
 // P forwards to p.gen.P after a Sprintf
 func (p *Plugin) P(s string, args ...interface{}) { p.gen.P(fmt.Sprintf(s, args...)) }
 
-func (p *Plugin) generateValidationCode(field *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation) {
+func (p *Plugin) generateValidationCode(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
 	if v == nil {
 		return
 	}
 
-	fieldValueAccessor := "m." + generator.CamelCase(field.GetName())
+	fieldValueAccessor := fieldAccessor
 	fieldName := field.GetName()
-	if isWKT(field.GetTypeName()) {
-		fieldValueAccessor = "m." + generator.CamelCase(field.GetName()) + ".Value"
-	}
 	if field.IsRepeated() {
-		fieldValueAccessor = "m." + generator.CamelCase(field.GetName()) + "[i]"
+		fieldValueAccessor = fieldValueAccessor + "[i]"
 	}
-
-	if v.TransformFunc != nil {
-		p.P("%s = %s(%s)", fieldValueAccessor, *v.TransformFunc, fieldValueAccessor)
+	if isWKTWrapper(field.GetTypeName()) {
+		fieldValueAccessor = fieldValueAccessor + ".Value"
 	}
+	if p.isPointerScalar(field) {
+		fieldValueAccessor = "*" + fieldAccessor
+	}
+
+	p.generateValueValidationCode(fieldName, fieldValueAccessor, field, v, mv, field)
+}
 
-	if isString(field) {
+// generateValueValidationCode dispatches to the type specific validation based on valueField, which is the field itself
+// for most things but is the key / value field of the entry when validating maps.  field is the one we report errors for
+func (p *Plugin) generateValueValidationCode(fieldName string, fieldValueAccessor string, valueField *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	// enums go first, they are never one of the other types but their names can look like one
+	if isEnum(valueField) {
+		p.generateEnumValidationCode(fieldName, fieldValueAccessor, valueField, v, mv, field)
+	} else if isString(valueField) {
 		p.generateStringValidationCode(fieldName, fieldValueAccessor, v, mv, field)
-	} else if isInt(field) {
+	} else if isInt(valueField) {
 		p.generateIntValidationCode(fieldName, fieldValueAccessor, v, mv, field)
-	} else if isFloat(field) {
+	} else if isFloat(valueField) {
 		p.generateFloatValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isBytes(valueField) {
+		p.generateBytesValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isBool(valueField) {
+		p.generateBoolValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isAny(valueField) {
+		p.generateAnyValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isStruct(valueField) {
+		p.generateStructValidationCode(fieldName, fieldValueAccessor, valueField, v, mv, field)
+	} else if isFieldMask(valueField) {
+		p.generateFieldMaskValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isTimestamp(valueField) {
+		p.generateTimestampValidationCode(fieldName, fieldValueAccessor, v, mv, field)
+	} else if isDuration(valueField) {
+		p.generateDurationValidationCode(fieldName, fieldValueAccessor, v, mv, field)
 	}
 }
 
@@ -185,6 +382,45 @@ func getFieldValidation(field *descriptor.FieldDescriptorProto) *pb.FieldValidat
 	return nil
 }
 
+// inlineRules strips the options Validate doesn't check along with the rest, transforms are left to Normalize while
+// conditions, field comparisons and cel rules run at the end.  This leaves nil if those were all v had
+func inlineRules(v *pb.FieldValidation) *pb.FieldValidation {
+	if v == nil {
+		return nil
+	}
+	rules := proto.Clone(v).(*pb.FieldValidation)
+	rules.Trim = nil
+	rules.Lc = nil
+	rules.Uc = nil
+	rules.TransformFunc = nil
+	rules.Cel = nil
+	rules.EqField = nil
+	rules.NeField = nil
+	rules.GtField = nil
+	rules.GteField = nil
+	rules.LtField = nil
+	rules.LteField = nil
+	rules.RequiredIf = nil
+	rules.RequiredUnless = nil
+	rules.ForbiddenIf = nil
+	onlyError := proto.Clone(rules).(*pb.FieldValidation)
+	onlyError.Error = nil
+	if proto.Equal(onlyError, &pb.FieldValidation{}) {
+		return nil
+	}
+	return rules
+}
+
+func getOneofValidation(oneof *descriptor.OneofDescriptorProto) *pb.OneofValidation {
+	if oneof.Options != nil {
+		v, err := proto.GetExtension(oneof.Options, pb.E_Oneof)
+		if err == nil && v.(*pb.OneofValidation) != nil {
+			return (v.(*pb.OneofValidation))
+		}
+	}
+	return nil
+}
+
 func getMessageValidation(msg *generator.Descriptor) *pb.MessageValidation {
 	if msg.Options != nil {
 		v, err := proto.GetExtension(msg.Options, pb.E_Message)
@@ -195,92 +431,95 @@ func getMessageValidation(msg *generator.Descriptor) *pb.MessageValidation {
 	return nil
 }
 
-func (p *Plugin) generateHelperFunctions() {
-	isValidUUID := `func isValidUUID(u string) bool {
-		_, err := ` + p.uuidPkg.Use() + `.Parse(u)
-		return err == nil
-	}
-	`
-	p.P(isValidUUID)
-
-	isValidEmail := `func isValidEmail(e string) bool {
-		_, err := ` + p.mailPkg.Use() + `.ParseAddress(e)
-		return err == nil
-	}`
-	p.P(isValidEmail)
-
-	isValidDate := `func isValidDate(f string, d string) bool {
-		_, err := ` + p.timePkg.Use() + `.Parse(f, d)
-		return err == nil
-	}`
-	p.P(isValidDate)
+// generateErrorCode outputs the code to add an error for this field.  rule is the machine readable name of the rule that
+// failed, requiredValue is what the rule wanted and actualValue is a go expression for the value we got, which can be
+// empty if there isn't one that makes sense
+func (p *Plugin) generateErrorCode(fieldName string, rule string, requiredValue string, actualValue string, errorMsg string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto, subErrorArray string) {
+	p.generateFieldErrorCode(fieldName, jsonName(field), p.fieldIndex(field), rule, requiredValue, actualValue, errorMsg, v, mv, subErrorArray)
 }
 
-func (p *Plugin) generateErrorType() {
-	ourErrorDef := `type ValidationError struct {
-		Field string
-		ErrorMessage string
-		Errors []*ValidationError
+// fieldIndex is the go expression for the index or key of an item in a repeated / map field, it is empty for any other
+// field since the name is known up front
+func (p *Plugin) fieldIndex(field *descriptor.FieldDescriptorProto) string {
+	if p.gen.IsMap(field) {
+		return fmt.Sprintf(`"["+%s.Sprint(k)+"]"`, p.fmtPkg.Use())
+	} else if field.IsRepeated() {
+		return fmt.Sprintf(`"["+%s.Itoa(i)+"]"`, p.strconvPkg.Use())
 	}
+	return ""
+}
 
-	type ValidationErrors struct {
-		Errors []*ValidationError
+// jsonName is the name of the field in json, which is what goes in the path.  protoc always fills in json_name, when
+// it hasn't we work it out the same way protoc does
+func jsonName(field *descriptor.FieldDescriptorProto) string {
+	if field.JsonName != nil {
+		return field.GetJsonName()
 	}
+	return lowerCamelCase(field.GetName())
+}
 
-	// Error will just return the first error we encountered, inspect the actual object for more details
-	func (e *ValidationErrors) Error() string {
-		if e != nil && e.Errors != nil && len(e.Errors) >= 1 {
-			return e.Errors[0].ErrorMessage
+// lowerCamelCase drops the underscores from a proto name and capitalizes the letter after each one, i.e. other_code
+// becomes otherCode
+func lowerCamelCase(name string) string {
+	b := strings.Builder{}
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+			continue
 		}
-		return ""
-	}
-
-	func GetValidationErrors(err error) ([]string, []string) {
-		fields := []string{}
-		errorMessages := []string{}
-		if err != nil {
-			if verr, ok := err.(*ValidationErrors); ok {
-				errors := verr.Errors
-				for i := 0; i < len(errors); i++ {
-					fields = append(fields, errors[i].Field)
-					errorMessages = append(errorMessages, errors[i].ErrorMessage)
-					if len(errors[i].Errors) != 0 {
-						errors = append(errors, errors[i].Errors...)
-					}
-				}
-			}
+		if upper {
+			c = unicode.ToUpper(c)
+			upper = false
 		}
-		return fields, errorMessages
+		b.WriteRune(c)
 	}
-	`
-	p.P(ourErrorDef)
+	return b.String()
 }
 
-func (p *Plugin) generateErrorCode(fieldName string, requiredValue string, errorMsg string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto, subErrorArray string) {
+// generateFieldErrorCode does the actual work for generateErrorCode.  The error's field is the proto name of the field
+// and its path the json name, index is a go expression for the index or key of an item in a repeated / map field which
+// goes on the end of both.  When it is empty the names are used as is, which is also what we want for errors about a
+// repeated / map field as a whole
+func (p *Plugin) generateFieldErrorCode(fieldName string, jsonName string, index string, rule string, requiredValue string, actualValue string, errorMsg string, v *pb.FieldValidation, mv *pb.MessageValidation, subErrorArray string) {
 	if v != nil && v.Error != nil {
 		errorMsg = v.GetError()
 	}
-	errorMsg = strings.ReplaceAll(errorMsg, "{value}", requiredValue)
 
 	if subErrorArray != "" {
-		p.P(`verr := ValidationError{Errors: make([]*ValidationError, len(msgvalerr.Errors))}`)
+		p.P(`verr := %s.ValidationError{Errors: make([]*%s.ValidationError, len(msgvalerr.Errors))}`, p.runtimePkg.Use(), p.runtimePkg.Use())
 	} else {
-		p.P(`verr := ValidationError{}`)
+		p.P(`verr := %s.ValidationError{}`, p.runtimePkg.Use())
 	}
 
-	if field.IsRepeated() {
-		errorMsg = strings.ReplaceAll(errorMsg, "{field}", `" + fieldName + "`)
-		p.strconvPkg.Use()
-		p.P(`fieldName := "%s["+strconv.Itoa(i)+"]"`, fieldName)
-		p.P(`verr.Field = fieldName`)
-		p.P(`verr.ErrorMessage = "%s"`, errorMsg)
+	// messages and values are whatever someone typed in the proto file, so everything gets quoted as a go string and
+	// {value} is only replaced after we split on {field} so a value containing {field} is left alone.  The path starts
+	// out as just the field, whoever validates us as a nested message will add to the front
+	if index != "" {
+		parts := strings.Split(errorMsg, "{field}")
+		for i := range parts {
+			parts[i] = strconv.Quote(strings.ReplaceAll(parts[i], "{value}", requiredValue))
+		}
+		p.P(`index := %s`, index)
+		p.P(`verr.Field = %q + index`, fieldName)
+		p.P(`verr.ErrorMessage = %s`, strings.Join(parts, " + verr.Field + "))
+		p.P(`verr.Path = %q + index`, jsonName)
 	} else {
-		errorMsg = strings.ReplaceAll(errorMsg, "{field}", fieldName)
-		p.P(`verr.Field = "%s"`, fieldName)
-		p.P(`verr.ErrorMessage = "%s"`, errorMsg)
+		errorMsg = strings.NewReplacer("{field}", fieldName, "{value}", requiredValue).Replace(errorMsg)
+		p.P(`verr.Field = %q`, fieldName)
+		p.P(`verr.ErrorMessage = %q`, errorMsg)
+		p.P(`verr.Path = %q`, jsonName)
+	}
+	p.P(`verr.Rule = %q`, p.rulePrefix+rule)
+	if requiredValue != "" {
+		p.P(`verr.Constraint = %q`, requiredValue)
+	}
+	if actualValue != "" {
+		p.P(`verr.Actual = %s`, actualValue)
 	}
 	if subErrorArray != "" {
 		p.P(`copy(verr.Errors, %s.Errors)`, subErrorArray)
+		p.P(`%s.PrefixPaths(verr.Path, verr.Errors)`, p.runtimePkg.Use())
 	}
 	p.P(`err.Errors = append(err.Errors, &verr)`)
 	if mv != nil && mv.ReturnOnError != nil && mv.GetReturnOnError() {
@@ -0,0 +1,24 @@
+package plugin
+
+import "testing"
+
+func TestImportedFile(t *testing.T) {
+	// c.proto imports a/all.proto, which is generated first and uses more imports than c.proto does
+	testGenerated(t, "imports")
+}
+
+func TestProto2(t *testing.T) {
+	testGenerated(t, "proto2")
+}
+
+func TestRequired(t *testing.T) {
+	testGenerated(t, "required")
+}
+
+func TestPaths(t *testing.T) {
+	testGenerated(t, "paths")
+}
+
+func TestRules(t *testing.T) {
+	testGenerated(t, "rules")
+}
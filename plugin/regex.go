@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+)
+
+// regexes are compiled once into package level vars instead of on every call to Validate.  Since files in the same
+// package share the namespace the var names include a hash of the file name, the same way gogo names its descriptor vars
+type regexVars struct {
+	prefix   string
+	names    map[string]string
+	patterns []string
+}
+
+func newRegexVars(file *generator.FileDescriptor) *regexVars {
+	h := sha256.Sum256([]byte(file.GetName()))
+	return &regexVars{
+		prefix: "regex_" + hex.EncodeToString(h[:8]) + "_",
+		names:  map[string]string{},
+	}
+}
+
+// regexVar returns the name of the var holding the compiled pattern.  The pattern is compiled here first so a bad one
+// fails generation instead of panicking the first time Validate is called, where says what it was for in the error
+func (p *Plugin) regexVar(where string, pattern string) string {
+	if _, err := regexp.Compile(pattern); err != nil {
+		p.gen.Fail(fmt.Sprintf("%s: invalid regex %s: %s", where, pattern, err))
+	}
+	if name, ok := p.regexes.names[pattern]; ok {
+		return name
+	}
+	name := fmt.Sprintf("%s%d", p.regexes.prefix, len(p.regexes.patterns))
+	p.regexes.names[pattern] = name
+	p.regexes.patterns = append(p.regexes.patterns, pattern)
+	return name
+}
+
+// generateRegexVars outputs every pattern we used in this file, it needs to run after all the Validate funcs
+func (p *Plugin) generateRegexVars() {
+	if len(p.regexes.patterns) == 0 {
+		return
+	}
+	p.P("var (")
+	for _, pattern := range p.regexes.patterns {
+		p.P("%s = %s.MustCompile(%q)", p.regexes.names[pattern], p.regexPkg.Use(), pattern)
+	}
+	p.P(")")
+}
@@ -0,0 +1,23 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegex(t *testing.T) {
+	testGenerated(t, "regex")
+}
+
+func TestInvalidRegex(t *testing.T) {
+	msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "validation.proto";
+message Bad {
+  string code = 1 [(validation.field).regex = "[a-z"];
+}
+`})
+	if !strings.Contains(msg, "field code: invalid regex [a-z: ") {
+		t.Errorf("unexpected error: %s", msg)
+	}
+}
@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/gogoproto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// generateRepeatedValidationCode handles the options that apply to a repeated field as a whole, anything else is applied
+// to each item by the usual code
+func (p *Plugin) generateRepeatedValidationCode(field *descriptor.FieldDescriptorProto, fieldAccessor string, v *pb.FieldValidation, mv *pb.MessageValidation) {
+	fieldName := field.GetName()
+
+	if v.MinItems != nil {
+		p.P(`if len(%s) < %d {`, fieldAccessor, v.GetMinItems())
+		p.generateFieldErrorCode(fieldName, jsonName(field), "", "repeated.min_items", fmt.Sprintf("%d", v.GetMinItems()), "len("+fieldAccessor+")", "{field} must have at least {value} items", v, mv, "")
+		p.P(`}`)
+	}
+	if v.MaxItems != nil {
+		p.P(`if len(%s) > %d {`, fieldAccessor, v.GetMaxItems())
+		p.generateFieldErrorCode(fieldName, jsonName(field), "", "repeated.max_items", fmt.Sprintf("%d", v.GetMaxItems()), "len("+fieldAccessor+")", "{field} must have no more than {value} items", v, mv, "")
+		p.P(`}`)
+	}
+	if v.Unique != nil && v.GetUnique() {
+		if field.IsMessage() {
+			p.gen.Fail(fmt.Sprintf("field %s: unique only works on scalars, use unique_by for messages", fieldName))
+		}
+		// interface{} keys save us from working out the go type, bytes aren't comparable so they become strings
+		itemAccessor := fieldAccessor + "[i]"
+		if isBytes(field) {
+			itemAccessor = "string(" + itemAccessor + ")"
+		}
+		p.P(`if len(%s) > 1 {`, fieldAccessor)
+		p.P(`seen := make(map[interface{}]bool, len(%s))`, fieldAccessor)
+		p.P(`for i := range %s {`, fieldAccessor)
+		p.P(`if seen[%s] {`, itemAccessor)
+		p.generateErrorCode(fieldName, "repeated.unique", "", itemAccessor, "{field} is a duplicate value", v, mv, field, "")
+		p.P(`}`)
+		p.P(`seen[%s] = true`, itemAccessor)
+		p.P(`}`)
+		p.P(`}`)
+	}
+	if v.UniqueBy != nil {
+		keyField := p.getUniqueByField(field, v.GetUniqueBy())
+		msg := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+		// the key is read directly since gogo can be told not to generate getters, so nil items and proto2 fields that
+		// aren't set are skipped, they don't have a value that could be a duplicate
+		itemAccessor := fieldAccessor + "[i]"
+		keyAccessor := itemAccessor + "." + generator.CamelCase(keyField.GetName())
+		skip := []string{}
+		if gogoproto.IsNullable(field) {
+			skip = append(skip, itemAccessor+" == nil")
+		}
+		key := keyAccessor
+		if pointerScalar(gogoproto.IsProto3(msg.File().FileDescriptorProto), keyField) {
+			skip = append(skip, keyAccessor+" == nil")
+			key = "*" + keyAccessor
+		} else if isBytes(keyField) {
+			key = "string(" + keyAccessor + ")"
+		}
+		p.P(`if len(%s) > 1 {`, fieldAccessor)
+		p.P(`seen := make(map[interface{}]bool, len(%s))`, fieldAccessor)
+		p.P(`for i := range %s {`, fieldAccessor)
+		if len(skip) != 0 {
+			p.P(`if %s {`, strings.Join(skip, " || "))
+			p.P(`continue`)
+			p.P(`}`)
+		}
+		p.P(`key := %s`, key)
+		p.P(`if seen[key] {`)
+		p.generateErrorCode(fieldName, "repeated.unique_by", keyField.GetName(), "key", "{field} has a duplicate {value}", v, mv, field, "")
+		p.P(`}`)
+		p.P(`seen[key] = true`)
+		p.P(`}`)
+		p.P(`}`)
+	}
+}
+
+// getUniqueByField finds the field named by unique_by in the repeated message, it has to be a scalar so we can use it as
+// a map key
+func (p *Plugin) getUniqueByField(field *descriptor.FieldDescriptorProto, name string) *descriptor.FieldDescriptorProto {
+	if !field.IsMessage() || isWKT(field.GetTypeName()) {
+		p.gen.Fail(fmt.Sprintf("field %s: unique_by only works on messages, use unique for scalars", field.GetName()))
+	}
+	msg := p.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+	for _, f := range msg.Field {
+		if f.GetName() == name {
+			if f.IsMessage() || f.IsRepeated() {
+				p.gen.Fail(fmt.Sprintf("field %s: unique_by field %s must be a scalar", field.GetName(), name))
+			}
+			if f.OneofIndex != nil {
+				p.gen.Fail(fmt.Sprintf("field %s: unique_by field %s can not be part of a oneof", field.GetName(), name))
+			}
+			return f
+		}
+	}
+	p.gen.Fail(fmt.Sprintf("field %s: unique_by field %s does not exist in %s", field.GetName(), name, msg.GetName()))
+	return nil
+}
+
+// hasItemRules is true if v has any options that apply to the individual items of a repeated field
+func hasItemRules(v *pb.FieldValidation) bool {
+	items := proto.Clone(v).(*pb.FieldValidation)
+	items.MinItems = nil
+	items.MaxItems = nil
+	items.Unique = nil
+	items.UniqueBy = nil
+	items.Error = nil
+	return !proto.Equal(items, &pb.FieldValidation{})
+}
@@ -0,0 +1,8 @@
+package plugin
+
+import "testing"
+
+func TestRepeated(t *testing.T) {
+	// getters are turned off, unique_by has to read the fields directly
+	testGenerated(t, "repeated")
+}
@@ -15,21 +15,12 @@ func (p *Plugin) generateStringValidationCode(fieldName string, fieldValue strin
 
 	closeBrackets := 0
 
-	if (v.Trim != nil && *v.Trim) || (mv != nil && mv.TrimStrings != nil && *mv.TrimStrings) {
-		p.P(`%s = %s.Trim(%s, " ")`, fieldValue, p.stringsPkg.Use(), fieldValue)
-	}
-	if v.Lc != nil && *v.Lc {
-		p.P(`%s = %s.ToLower(%s)`, fieldValue, p.stringsPkg.Use(), fieldValue)
-	}
-	if v.Uc != nil && *v.Uc {
-		p.P(`%s = %s.ToUpper(%s)`, fieldValue, p.stringsPkg.Use(), fieldValue)
-	}
 	if v.NotEmptyString != nil {
 		// For empty string checks, there is no point in doing furhter validation if we have an empty string, so
 		// while it makes this code a bit uglier, try to build a decent looking if around further validation
 		if *v.NotEmptyString {
 			p.P(`if %s == "" {`, fieldValue)
-			p.generateErrorCode(fieldName, "", "{field} can not be an empty string", v, mv, field, "")
+			p.generateErrorCode(fieldName, "string.not_empty", "", fieldValue, "{field} can not be an empty string", v, mv, field, "")
 
 			if getNumberOfValidationOptions(v) > 1 {
 				// we will close this out at the end of this function
@@ -47,48 +38,48 @@ func (p *Plugin) generateStringValidationCode(fieldName string, fieldValue strin
 		}
 	}
 	if v.Matches != nil {
-		p.P(`if %s != "%s" {`, fieldValue, v.GetMatches())
-		p.generateErrorCode(fieldName, v.GetMatches(), "{field} must equal {value}", v, mv, field, "")
+		p.P(`if %s != %q {`, fieldValue, v.GetMatches())
+		p.generateErrorCode(fieldName, "string.matches", v.GetMatches(), fieldValue, "{field} must equal {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.Contains != nil {
-		p.P(`if !%s.Contains(%s, "%s") {`, p.stringsPkg.Use(), fieldValue, v.GetContains())
-		p.generateErrorCode(fieldName, v.GetContains(), "{field} must contain {value}", v, mv, field, "")
+		p.P(`if !%s.Contains(%s, %q) {`, p.stringsPkg.Use(), fieldValue, v.GetContains())
+		p.generateErrorCode(fieldName, "string.contains", v.GetContains(), fieldValue, "{field} must contain {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.Regex != nil {
-		p.P(`if !%s.MustCompile("%s").MatchString(%s) {`, p.regexPkg.Use(), v.GetRegex(), fieldValue)
-		p.generateErrorCode(fieldName, v.GetRegex(), "{field} must match regex {value}", v, mv, field, "")
+		p.P(`if !%s.MatchString(%s) {`, p.regexVar("field "+fieldName, v.GetRegex()), fieldValue)
+		p.generateErrorCode(fieldName, "string.regex", v.GetRegex(), fieldValue, "{field} must match regex {value}", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.MinLen != nil {
 		p.P(`if len(%s) < %d {`, fieldValue, v.GetMinLen())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetMinLen()), "{field} must be at least {value} characters long", v, mv, field, "")
+		p.generateErrorCode(fieldName, "string.min_len", fmt.Sprintf("%d", v.GetMinLen()), fieldValue, "{field} must be at least {value} characters long", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.MaxLen != nil {
 		p.P(`if len(%s) > %d {`, fieldValue, v.GetMaxLen())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetMaxLen()), "{field} must be no more than {value} characters long", v, mv, field, "")
+		p.generateErrorCode(fieldName, "string.max_len", fmt.Sprintf("%d", v.GetMaxLen()), fieldValue, "{field} must be no more than {value} characters long", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.EqLen != nil {
 		p.P(`if len(%s) != %d {`, fieldValue, v.GetEqLen())
-		p.generateErrorCode(fieldName, fmt.Sprintf("%d", v.GetEqLen()), "{field} must be exactly {value} characters long", v, mv, field, "")
+		p.generateErrorCode(fieldName, "string.eq_len", fmt.Sprintf("%d", v.GetEqLen()), fieldValue, "{field} must be exactly {value} characters long", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.IsUuid != nil && *v.IsUuid {
-		p.P(`if !isValidUUID(%s) {`, fieldValue)
-		p.generateErrorCode(fieldName, "", "{field} must be a valid UUID", v, mv, field, "")
+		p.P(`if !%s.IsValidUUID(%s) {`, p.runtimePkg.Use(), fieldValue)
+		p.generateErrorCode(fieldName, "string.is_uuid", "", fieldValue, "{field} must be a valid UUID", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.IsEmail != nil && *v.IsEmail {
-		p.P(`if !isValidEmail(%s) {`, fieldValue)
-		p.generateErrorCode(fieldName, "", "{field} must be a valid email address", v, mv, field, "")
+		p.P(`if !%s.IsValidEmail(%s) {`, p.runtimePkg.Use(), fieldValue)
+		p.generateErrorCode(fieldName, "string.is_email", "", fieldValue, "{field} must be a valid email address", v, mv, field, "")
 		p.P(`}`)
 	}
 	if v.IsIso8601Date != nil && *v.IsIso8601Date {
-		p.P(`if !isValidDate("%s", %s) {`, "2006-01-02", fieldValue)
-		p.generateErrorCode(fieldName, "", "{field} must be a date in the format YYYY-MM-DD", v, mv, field, "")
+		p.P(`if !%s.IsValidDate(%q, %s) {`, p.runtimePkg.Use(), "2006-01-02", fieldValue)
+		p.generateErrorCode(fieldName, "string.is_iso8601_date", "", fieldValue, "{field} must be a date in the format YYYY-MM-DD", v, mv, field, "")
 		p.P(`}`)
 	}
 
@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestQuoting(t *testing.T) {
+	testGenerated(t, "quoting")
+}
@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// structKinds are the names struct_kinds accepts, they match the kinds of a google.protobuf.Value
+var structKinds = map[string]bool{
+	"null":   true,
+	"number": true,
+	"string": true,
+	"bool":   true,
+	"struct": true,
+	"list":   true,
+}
+
+// Struct / Value / ListValue can nest any number of levels so the walking happens in the runtime, we just pass it the
+// rules.  fieldValue is the pointer to the message itself
+func (p *Plugin) generateStructValidationCode(fieldName string, fieldValue string, valueField *descriptor.FieldDescriptorProto, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	rules := []string{}
+	if v.StructMaxKeys != nil {
+		rules = append(rules, fmt.Sprintf("MaxKeys: %d", v.GetStructMaxKeys()))
+	}
+	if v.StructKeyRegex != nil {
+		rules = append(rules, "KeyRegex: "+p.regexVar("field "+fieldName, v.GetStructKeyRegex()))
+	}
+	if v.StructMaxDepth != nil {
+		rules = append(rules, fmt.Sprintf("MaxDepth: %d", v.GetStructMaxDepth()))
+	}
+	if v.StructMaxListLen != nil {
+		rules = append(rules, fmt.Sprintf("MaxListLen: %d", v.GetStructMaxListLen()))
+	}
+	if len(v.StructKinds) != 0 {
+		for _, kind := range v.StructKinds {
+			if !structKinds[kind] {
+				p.gen.Fail(fmt.Sprintf("field %s: %s is not a kind of value, use one of null, number, string, bool, struct, list", fieldName, kind))
+			}
+		}
+		rules = append(rules, fmt.Sprintf("Kinds: []string{%s}", quoteStrings(v.StructKinds)))
+	}
+	if len(rules) == 0 {
+		return
+	}
+
+	validateFunc := "ValidateStruct"
+	if valueField.GetTypeName() == wktBasePath+"Value" {
+		validateFunc = "ValidateValue"
+	} else if valueField.GetTypeName() == wktBasePath+"ListValue" {
+		validateFunc = "ValidateListValue"
+	}
+	name, path := strconv.Quote(fieldName), strconv.Quote(jsonName(field))
+	if index := p.fieldIndex(field); index != "" {
+		name, path = name+"+"+index, path+"+"+index
+	}
+
+	// the runtime builds the errors since it knows the path into the struct, all we have left to do is apply a custom
+	// error message and return_on_error
+	p.P(`for _, structerr := range %s.%s(%s, %s, %s, %s.StructRules{%s}) {`, p.runtimePkg.Use(), validateFunc, name, path, fieldValue, p.runtimePkg.Use(), strings.Join(rules, ", "))
+	if v.Error != nil {
+		p.P(`structerr.ErrorMessage = %s.NewReplacer("{field}", structerr.Field, "{value}", structerr.Constraint).Replace(%q)`, p.stringsPkg.Use(), v.GetError())
+	}
+	p.P(`err.Errors = append(err.Errors, structerr)`)
+	if mv.GetReturnOnError() {
+		p.P(`return &err`)
+	}
+	p.P(`}`)
+}
+
+func isStruct(field *descriptor.FieldDescriptorProto) bool {
+	switch field.GetTypeName() {
+	case wktBasePath + "Struct", wktBasePath + "Value", wktBasePath + "ListValue":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package plugin
+
+import "testing"
+
+func TestStructs(t *testing.T) {
+	testGenerated(t, "structs")
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/any/pb"
+)
+
+func pack(msg proto.Message) *types.Any {
+	a, err := types.MarshalAny(msg)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func main() {
+	valid := &pb.Holder{
+		Payload: pack(&pb.Order{Id: "a"}),
+		Other:   pack(&pb.Order{Id: "b"}),
+		Items:   []*types.Any{pack(&pb.Order{Id: "c"}), pack(&pb.Refund{})},
+	}
+	show("valid", valid.Validate())
+
+	// nothing to check on an Any that isn't set
+	show("empty", (&pb.Holder{}).Validate())
+
+	invalid := &pb.Holder{
+		Payload: pack(&pb.Order{}),
+		Other:   pack(&pb.Refund{Id: "a"}),
+		Items:   []*types.Any{pack(&pb.Order{Id: "c"}), {TypeUrl: "type.googleapis.com/pb.Unknown"}},
+	}
+	show("invalid", invalid.Validate())
+
+	show("not allowed", (&pb.Holder{Payload: &types.Any{TypeUrl: "type.googleapis.com/pb.Unknown"}}).Validate())
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/bools/pb"
+)
+
+func main() {
+	valid := &pb.Bools{AcceptedTerms: true, Subscribed: &types.BoolValue{Value: true}}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Bools{OptedOut: true, Subscribed: &types.BoolValue{}}
+	show("invalid", invalid.Validate())
+
+	// a BoolValue with bool_const has to be set
+	show("unset", (&pb.Bools{AcceptedTerms: true}).Validate())
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/bytes/pb"
+)
+
+func main() {
+	valid := &pb.Bytes{
+		Min:     []byte("ab"),
+		Eq:      []byte("ab"),
+		Affixes: []byte("ab\x00yz"),
+		Pattern: []byte("c0ffee"),
+		List:    [][]byte{[]byte("a")},
+	}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Bytes{
+		Min:     []byte("a"),
+		Max:     []byte("abc"),
+		Eq:      []byte("abc"),
+		Affixes: []byte("yzab"),
+		Pattern: []byte("tea"),
+		Wrapped: &types.BytesValue{},
+		List:    [][]byte{[]byte("a"), []byte("ab")},
+	}
+	show("invalid", invalid.Validate())
+}
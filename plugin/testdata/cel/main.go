@@ -0,0 +1,24 @@
+package main
+
+import "github.com/neophenix/protoc-gen-validation/plugin/_gentest/cel/pb"
+
+func main() {
+	valid := &pb.Cel{
+		Name:   "xa",
+		Count:  2,
+		Ranges: []*pb.Range{{Low: 1, High: 2}, {Low: 2, High: 2}, {Low: 0, High: 5}},
+		Range:  &pb.Range{Low: 1, High: 1},
+	}
+	show("valid", valid.Validate())
+
+	// message rules have message as their field, nested ones get the path to the message in front
+	invalid := &pb.Cel{
+		Name:   "",
+		Count:  3,
+		Ranges: []*pb.Range{{Low: 1, High: 2}, {Low: 3, High: 2}, {}},
+		Range:  &pb.Range{Low: 2, High: 1},
+	}
+	show("invalid", invalid.Validate())
+
+	show("range", (&pb.Range{Low: 2, High: 1}).Validate())
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/compare/pb"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/compare/pb2"
+)
+
+func main() {
+	valid := &pb.Compare{
+		MinPrice:        1,
+		MaxPrice:        1,
+		Password:        "a",
+		PasswordConfirm: "a",
+		OldKey:          []byte("a"),
+		NewKey:          []byte("b"),
+		Level:           pb.Level_HIGH,
+		Wanted:          pb.Level_HIGH,
+		Low:             1,
+		High:            1.5,
+		Limit:           &pb.Compare_Upper{Upper: 1},
+		Cap:             2,
+	}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Compare{
+		MinPrice:        2,
+		MaxPrice:        1,
+		Password:        "a",
+		PasswordConfirm: "b",
+		OldKey:          []byte("a"),
+		NewKey:          []byte("a"),
+		Wanted:          pb.Level_HIGH,
+		Low:             1,
+		High:            1,
+		Limit:           &pb.Compare_Upper{Upper: 2},
+		Cap:             2,
+	}
+	show("invalid", invalid.Validate())
+
+	// a oneof case that isn't set compares as 0, the same as in cel
+	show("other case", (&pb.Compare{High: 1, Limit: &pb.Compare_Lower{Lower: 5}}).Validate())
+
+	show("proto2 valid", (&pb2.Legacy{Min: proto.Int32(1), Max: proto.Int32(2), Limit: proto.Int32(5)}).Validate())
+
+	// unset proto2 fields compare as their default, 0, 10 and 5 and MEDIUM here
+	show("proto2 unset", (&pb2.Legacy{Min: proto.Int32(1), Wanted: pb2.Level_HIGH.Enum()}).Validate())
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/conditional/pb"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/conditional/pb2"
+)
+
+func main() {
+	valid := &pb.Payment{
+		PaymentType: pb.PaymentType_CARD,
+		CardNumber:  "1",
+		Tip:         1,
+		TipReason:   "good",
+		Country:     "IE",
+		Rate:        0.5,
+		Contact:     &pb.Payment_Email{Email: "a@b.com"},
+	}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Payment{
+		PaymentType: pb.PaymentType_BANK,
+		NoTip:       true,
+		Tip:         2,
+		TipReason:   "bad",
+		Rate:        0.5,
+		Contact:     &pb.Payment_Phone{Phone: "1"},
+	}
+	show("invalid", invalid.Validate())
+
+	show("card", (&pb.Payment{PaymentType: pb.PaymentType_CARD, Tip: -1, Postcode: "a"}).Validate())
+
+	show("proto2 set", (&pb2.Legacy{Level: proto.Int32(1), Count: proto.Uint32(1)}).Validate())
+	// unset proto2 fields have their default value in conditions, so both of these match
+	show("proto2 unset", (&pb2.Legacy{}).Validate())
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/enums/pb"
+)
+
+func main() {
+	valid := &pb.Enums{In: pb.Color_GREEN, NotIn: pb.Color_RED, List: []pb.Color{pb.Color_BLUE}, ByName: map[string]pb.Color{"a": pb.Color_BLUE}}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Enums{Defined: 7, In: pb.Color_BLUE, List: []pb.Color{pb.Color_RED, 9}, ByName: map[string]pb.Color{"a": pb.Color_RED}, Small: &types.Int32Value{}, Big: &types.UInt64Value{Value: 11}}
+	show("invalid", invalid.Validate())
+}
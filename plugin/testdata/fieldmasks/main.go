@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/fieldmasks/pb"
+)
+
+func mask(paths ...string) *pb.UpdateUser {
+	return &pb.UpdateUser{UpdateMask: &types.FieldMask{Paths: paths}}
+}
+
+func main() {
+	show("valid", mask("address", "address.city", "address.post_code", "tags").Validate())
+	show("empty", (&pb.UpdateUser{}).Validate())
+	show("disallowed", mask("id", "password", "manager.address.city").Validate())
+	// paths are proto names, not json names, and can't go into a repeated field
+	show("unknown", mask("nope", "address.postCode", "tags.x").Validate())
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/imports/a"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/imports/c"
+)
+
+func main() {
+	at, _ := types.TimestampProto(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	valid := &a.All{Code: "abc", Data: []byte("abc"), At: at, Name: "héy"}
+	show("valid", (&c.C{Id: "1", All: valid}).Validate())
+
+	before, _ := types.TimestampProto(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	invalid := &a.All{Code: "ABC", Data: []byte("cba"), At: before, Name: "hello"}
+	show("invalid", (&c.C{All: invalid}).Validate())
+}
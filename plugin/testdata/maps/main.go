@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/maps/pb"
+)
+
+func main() {
+	valid := &pb.Maps{
+		Labels: map[string]string{"ab": "abc"},
+		Items:  map[int32]*pb.Item{1: {Name: "one"}},
+		Counts: map[string]*types.Int64Value{"a": {Value: 1}, "b": nil},
+	}
+	show("valid", valid.Validate())
+
+	show("empty", (&pb.Maps{}).Validate())
+	show("too many", (&pb.Maps{Labels: map[string]string{"ab": "", "cd": "", "ef": ""}}).Validate())
+
+	// the key and the value fail with the same path, the rule says which one it was
+	show("bad key", (&pb.Maps{Labels: map[string]string{"a": "abc"}}).Validate())
+	show("bad value", (&pb.Maps{Labels: map[string]string{"ab": "abcd"}}).Validate())
+
+	invalid := &pb.Maps{
+		Labels: map[string]string{"ab": "abc"},
+		Items:  map[int32]*pb.Item{2: {}},
+		Counts: map[string]*types.Int64Value{"a": {Value: -1}},
+	}
+	show("invalid", invalid.Validate())
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/normalize/pb"
+	"github.com/neophenix/protoc-gen-validation/runtime"
+)
+
+func main() {
+	for _, msg := range []interface{}{&pb.Name{}, &pb.Plain{}, &pb.Outer{}, &pb.Tree{}, &pb.NamedTree{}, &pb.InValidate{}, &pb.PlainInValidate{}} {
+		_, ok := msg.(runtime.Normalizer)
+		fmt.Printf("%T normalizes: %v\n", msg, ok)
+	}
+
+	outer := &pb.Outer{
+		Name:   &pb.Name{Value: " A "},
+		Names:  []*pb.Name{{Value: " B "}, nil},
+		ByKey:  map[string]*pb.Name{"k": {Value: " C "}},
+		Plain:  &pb.Plain{Value: " D "},
+		Choice: &pb.Outer_Chosen{Chosen: &pb.Name{Value: " E "}},
+	}
+	outer.Normalize()
+	fmt.Printf("outer: %q %q %q %q %q\n", outer.Name.Value, outer.Names[0].Value, outer.ByKey["k"].Value, outer.Plain.Value, outer.GetChosen().Value)
+
+	tree := &pb.NamedTree{Value: "a", Child: &pb.NamedTree{Value: "b"}}
+	tree.Normalize()
+	fmt.Printf("tree: %q %q\n", tree.Value, tree.Child.Value)
+
+	// Validate normalizes first, so the trimmed value is short enough
+	inValidate := &pb.InValidate{Value: " ab "}
+	show("in validate", inValidate.Validate())
+	fmt.Printf("in validate: %q\n", inValidate.Value)
+	show("plain in validate", (&pb.PlainInValidate{Value: " ab "}).Validate())
+}
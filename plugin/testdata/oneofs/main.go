@@ -0,0 +1,13 @@
+package main
+
+import "github.com/neophenix/protoc-gen-validation/plugin/_gentest/oneofs/pb"
+
+func main() {
+	show("card", (&pb.Oneofs{Payment: &pb.Oneofs_Card{Card: &pb.Card{Number: "1234"}}}).Validate())
+	show("cash", (&pb.Oneofs{Payment: &pb.Oneofs_Cash{Cash: true}, Note: &pb.Oneofs_Text{}}).Validate())
+	show("unset", (&pb.Oneofs{}).Validate())
+	show("bad card", (&pb.Oneofs{Payment: &pb.Oneofs_Card{Card: &pb.Card{Number: "1"}}}).Validate())
+	show("bad account", (&pb.Oneofs{Payment: &pb.Oneofs_Account{Account: "a"}}).Validate())
+	show("bad email", (&pb.Oneofs{Payment: &pb.Oneofs_Cash{}, Contact: &pb.Oneofs_Email{Email: "nope"}}).Validate())
+	show("phone", (&pb.Oneofs{Payment: &pb.Oneofs_Cash{}, Contact: &pb.Oneofs_Phone{Phone: "nope"}}).Validate())
+}
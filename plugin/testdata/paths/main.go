@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/paths/pb"
+)
+
+func main() {
+	valid := &pb.Order{
+		CustomerName: "a",
+		OrderLines:   []*pb.Line{{ProductCode: "abc", UnitCount: 1}},
+		LinesByCode:  map[string]*pb.Line{"abc": {ProductCode: "abc", UnitCount: 1}},
+		PayWith:      &pb.Order_CardNumber{CardNumber: "1"},
+	}
+	show("valid", valid.Validate())
+
+	// fields are the proto names and paths the json names, including a json_name set in the proto
+	invalid := &pb.Order{
+		OrderLines:  []*pb.Line{{ProductCode: "abc", UnitCount: 1}, {ProductCode: "a"}},
+		LinesByCode: map[string]*pb.Line{"abc": {ProductCode: "abc"}},
+		ExtraData: &types.Struct{Fields: map[string]*types.Value{
+			"a": {Kind: &types.Value_NullValue{}},
+			"b": {Kind: &types.Value_NullValue{}},
+		}},
+	}
+	show("invalid", invalid.Validate())
+}
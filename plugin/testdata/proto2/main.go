@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/proto2/pb"
+)
+
+func main() {
+	valid := &pb.Proto2{
+		Id:    proto.String(""),
+		Count: proto.Int32(1),
+		Inner: &pb.Inner{},
+		At:    &types.Timestamp{},
+		Data:  []byte("a"),
+		Nums:  []int64{1, 10},
+	}
+	show("valid", valid.Validate())
+
+	// every required field is missing, optional ones that aren't set are skipped
+	show("unset", (&pb.Proto2{}).Validate())
+
+	invalid := &pb.Proto2{
+		Id:    proto.String("1"),
+		Count: proto.Int32(0),
+		Label: proto.String("a"),
+		Inner: &pb.Inner{Name: proto.String("long")},
+		At:    &types.Timestamp{},
+		Data:  []byte{},
+		Other: &pb.Inner{Name: proto.String("long")},
+		Nums:  []int64{11},
+	}
+	show("invalid", invalid.Validate())
+}
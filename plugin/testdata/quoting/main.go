@@ -0,0 +1,8 @@
+package main
+
+import "github.com/neophenix/protoc-gen-validation/plugin/_gentest/quoting/pb"
+
+func main() {
+	show("valid", (&pb.Quoting{Quote: `say "hi"`, Backslash: `C:\Windows`, Message: "ab", Braces: "{field}"}).Validate())
+	show("invalid", (&pb.Quoting{Quote: "hi", Backslash: "/", Message: "a", Braces: "braces", Items: []string{"ab"}}).Validate())
+}
@@ -0,0 +1,9 @@
+package main
+
+import "github.com/neophenix/protoc-gen-validation/plugin/_gentest/regex/pb"
+
+func main() {
+	show("valid", (&pb.First{Code: "ABC", OtherCode: "XYZ", Hex: []byte("c0ffee")}).Validate())
+	show("invalid", (&pb.First{Code: "abc", OtherCode: "ABCD", Hex: []byte("tea")}).Validate())
+	show("second", (&pb.Second{Code: "AB"}).Validate())
+}
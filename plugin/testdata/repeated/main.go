@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/repeated/pb"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/repeated/pb2"
+)
+
+func main() {
+	valid := &pb.Repeated{
+		Tags:   []string{"a", "b"},
+		Blobs:  [][]byte{[]byte("a"), []byte("b")},
+		ById:   []*pb.Item{{Id: "a"}, {Id: "b"}},
+		ByHash: []*pb.Item{{Hash: []byte("a")}, {Hash: []byte("b")}},
+		// codes that aren't set aren't duplicates of each other
+		Legacy: []*pb2.Legacy{{}, {Code: proto.Int32(0)}, {}},
+	}
+	show("valid", valid.Validate())
+
+	show("empty", (&pb.Repeated{}).Validate())
+
+	invalid := &pb.Repeated{
+		Tags:  []string{"a", "b", "a", "c"},
+		Blobs: [][]byte{[]byte("a"), []byte("a")},
+		// a nil item has no id to compare, Validate still reports it as nil
+		ById:   []*pb.Item{{Id: "a"}, nil, {Id: "a"}},
+		ByHash: []*pb.Item{{Hash: []byte("a")}, {Hash: []byte("a")}},
+		Legacy: []*pb2.Legacy{{Code: proto.Int32(1)}, {}, {Code: proto.Int32(1)}},
+	}
+	show("invalid", invalid.Validate())
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/required/pb"
+)
+
+func main() {
+	show("set", (&pb.Required{Inner: &pb.Inner{}, At: &types.Timestamp{}, Flag: &types.BoolValue{Value: true}}).Validate())
+	show("unset", (&pb.Required{}).Validate())
+	var nilMessage *pb.Required
+	show("nil", nilMessage.Validate())
+}
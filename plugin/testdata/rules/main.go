@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/rules/pb"
+	"github.com/neophenix/protoc-gen-validation/runtime"
+)
+
+func main() {
+	valid := &pb.Rules{
+		NotEmpty: "a",
+		Matches:  "yes",
+		Contains: "a@b",
+		Short:    "ab",
+		Exact:    "ab",
+		Id:       "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		Email:    "a@b.com",
+		Date:     "2019-01-02",
+		Small:    5,
+		Answer:   42,
+		Ratio:    0.5,
+		Half:     0.5,
+		Inner:    &pb.Inner{Name: "a"},
+	}
+	show("valid", valid.Validate())
+
+	invalid := &pb.Rules{
+		Matches:  "no",
+		Contains: "ab",
+		Short:    "abc",
+		Exact:    "a",
+		Id:       "nope",
+		Email:    "nope",
+		Date:     "2019-13-02",
+		Small:    6,
+		Answer:   41,
+		Ratio:    0.25,
+		Half:     1,
+		Inner:    &pb.Inner{},
+	}
+	show("invalid", invalid.Validate())
+
+	// the rule, what it wanted and what it got are there for every error so nobody has to read the message
+	for _, e := range invalid.Validate().(*runtime.ValidationErrors).Errors {
+		fmt.Printf("%s: constraint %q actual %#v\n", e.Rule, e.Constraint, e.Actual)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/structs/pb"
+)
+
+func str(s string) *types.Value {
+	return &types.Value{Kind: &types.Value_StringValue{StringValue: s}}
+}
+
+func num(n float64) *types.Value {
+	return &types.Value{Kind: &types.Value_NumberValue{NumberValue: n}}
+}
+
+func list(values ...*types.Value) *types.Value {
+	return &types.Value{Kind: &types.Value_ListValue{ListValue: &types.ListValue{Values: values}}}
+}
+
+func object(fields map[string]*types.Value) *types.Value {
+	return &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{Fields: fields}}}
+}
+
+func main() {
+	valid := &pb.Structs{
+		Metadata: &types.Struct{Fields: map[string]*types.Value{"a": str("x"), "b": object(map[string]*types.Value{"c": num(1)})}},
+		Value:    list(str("a"), list()),
+		List:     &types.ListValue{Values: []*types.Value{num(1), num(2)}},
+		Tags:     []*types.Struct{{Fields: map[string]*types.Value{"a": str("x")}}},
+	}
+	show("valid", valid.Validate())
+
+	show("empty", (&pb.Structs{}).Validate())
+
+	invalid := &pb.Structs{
+		Metadata: &types.Struct{Fields: map[string]*types.Value{
+			"a":   str("x"),
+			"Bad": str("y"),
+			"c":   object(map[string]*types.Value{"d": object(nil)}),
+		}},
+		Value: list(str("a"), num(1)),
+		List:  &types.ListValue{Values: []*types.Value{num(1), num(2), list(num(1), num(2), num(3))}},
+		Tags:  []*types.Struct{{}, {Fields: map[string]*types.Value{"a": str("x"), "b": str("y")}}},
+	}
+	show("invalid", invalid.Validate())
+}
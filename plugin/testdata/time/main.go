@@ -0,0 +1,55 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/neophenix/protoc-gen-validation/plugin/_gentest/time/pb"
+	"github.com/neophenix/protoc-gen-validation/runtime"
+)
+
+func ts(s string) *types.Timestamp {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	pbts, err := types.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	return pbts
+}
+
+func main() {
+	// the time relative rules use runtime.Now, so the output doesn't depend on when this runs
+	runtime.Now = func() time.Time { return time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	valid := &pb.Times{
+		Created: ts("2020-05-01T00:00:00Z"),
+		Expires: ts("2020-07-01T00:00:00Z"),
+		Seen:    ts("2020-05-31T23:30:00Z"),
+		Ttl:     types.DurationProto(time.Hour),
+		History: []*types.Timestamp{ts("2020-05-01T00:00:00Z")},
+	}
+	show("valid", valid.Validate())
+
+	// unset timestamps other than required ones and unset durations are left alone
+	show("required", (&pb.Times{}).Validate())
+
+	invalid := &pb.Times{
+		Created: ts("2019-05-01T00:00:00Z"),
+		Expires: ts("2021-07-01T00:00:00Z"),
+		Seen:    ts("2020-06-01T02:00:00Z"),
+		Ttl:     types.DurationProto(time.Millisecond),
+		History: []*types.Timestamp{ts("2020-05-01T00:00:00Z"), ts("2020-07-01T00:00:00Z")},
+	}
+	show("invalid", invalid.Validate())
+
+	past := &pb.Times{
+		Created: ts("2020-07-01T00:00:00Z"),
+		Expires: ts("2020-05-01T00:00:00Z"),
+		Seen:    ts("2020-05-31T22:00:00Z"),
+		Ttl:     types.DurationProto(48 * time.Hour),
+	}
+	show("past", past.Validate())
+}
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pb "github.com/neophenix/protoc-gen-validation"
+)
+
+// Timestamp and Duration are converted from their seconds / nanos so we don't need to know which package the go type
+// came from, fieldValue is the pointer to the message itself
+func (p *Plugin) generateTimestampValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if v.TsLt != nil {
+		ts := p.timestampValue(fieldValue)
+		bound := p.parseTime(fieldName, "ts_lt", v.GetTsLt())
+		p.P(`if !%s.Before(%s) {`, ts, p.timeLiteral(bound))
+		p.generateErrorCode(fieldName, "timestamp.lt", bound.Format(time.RFC3339Nano), ts, "{field} must be before {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.TsGt != nil {
+		ts := p.timestampValue(fieldValue)
+		bound := p.parseTime(fieldName, "ts_gt", v.GetTsGt())
+		p.P(`if !%s.After(%s) {`, ts, p.timeLiteral(bound))
+		p.generateErrorCode(fieldName, "timestamp.gt", bound.Format(time.RFC3339Nano), ts, "{field} must be after {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.GetTsLtNow() {
+		ts := p.timestampValue(fieldValue)
+		p.P(`if !%s.Before(%s.Now()) {`, ts, p.runtimePkg.Use())
+		p.generateErrorCode(fieldName, "timestamp.lt_now", "", ts, "{field} must be in the past", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.GetTsGtNow() {
+		ts := p.timestampValue(fieldValue)
+		p.P(`if !%s.After(%s.Now()) {`, ts, p.runtimePkg.Use())
+		p.generateErrorCode(fieldName, "timestamp.gt_now", "", ts, "{field} must be in the future", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.TsWithin != nil {
+		ts := p.timestampValue(fieldValue)
+		within := p.parseDuration(fieldName, "ts_within", v.GetTsWithin())
+		p.P(`if d := %s.Now().Sub(%s); d > %s || d < -%s {`, p.runtimePkg.Use(), ts, p.durationLiteral(within), p.durationLiteral(within))
+		p.generateErrorCode(fieldName, "timestamp.within", within.String(), ts, "{field} must be within {value} of now", v, mv, field, "")
+		p.P(`}`)
+	}
+}
+
+func (p *Plugin) generateDurationValidationCode(fieldName string, fieldValue string, v *pb.FieldValidation, mv *pb.MessageValidation, field *descriptor.FieldDescriptorProto) {
+	if v.DurationLte != nil {
+		dur := p.durationValue(fieldValue)
+		bound := p.parseDuration(fieldName, "duration_lte", v.GetDurationLte())
+		p.P(`if %s > %s {`, dur, p.durationLiteral(bound))
+		p.generateErrorCode(fieldName, "duration.lte", bound.String(), dur, "{field} must be no more than {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+	if v.DurationGte != nil {
+		dur := p.durationValue(fieldValue)
+		bound := p.parseDuration(fieldName, "duration_gte", v.GetDurationGte())
+		p.P(`if %s < %s {`, dur, p.durationLiteral(bound))
+		p.generateErrorCode(fieldName, "duration.gte", bound.String(), dur, "{field} must be at least {value}", v, mv, field, "")
+		p.P(`}`)
+	}
+}
+
+// parseTime fails generation if an option isn't an RFC 3339 time
+func (p *Plugin) parseTime(fieldName string, option string, value string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		p.gen.Fail(fmt.Sprintf("field %s: %s %q is not an RFC 3339 time", fieldName, option, value))
+	}
+	return t
+}
+
+// parseDuration fails generation if an option isn't something time.ParseDuration understands
+func (p *Plugin) parseDuration(fieldName string, option string, value string) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		p.gen.Fail(fmt.Sprintf("field %s: %s %q is not a duration", fieldName, option, value))
+	}
+	return d
+}
+
+// timestampValue and durationValue are only called when a rule needs them, so we don't import time for nothing
+func (p *Plugin) timestampValue(fieldValue string) string {
+	return fmt.Sprintf("%s.Unix(%s.Seconds, int64(%s.Nanos))", p.timePkg.Use(), fieldValue, fieldValue)
+}
+
+func (p *Plugin) durationValue(fieldValue string) string {
+	return fmt.Sprintf("(%s.Duration(%s.Seconds)*%s.Second + %s.Duration(%s.Nanos))", p.timePkg.Use(), fieldValue, p.timePkg.Use(), p.timePkg.Use(), fieldValue)
+}
+
+func (p *Plugin) timeLiteral(t time.Time) string {
+	return fmt.Sprintf("%s.Unix(%d, %d)", p.timePkg.Use(), t.Unix(), t.Nanosecond())
+}
+
+func (p *Plugin) durationLiteral(d time.Duration) string {
+	return fmt.Sprintf("%s.Duration(%d)", p.timePkg.Use(), int64(d))
+}
+
+func isTimestamp(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == wktBasePath+"Timestamp"
+}
+
+func isDuration(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetTypeName() == wktBasePath+"Duration"
+}
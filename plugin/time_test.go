@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTime(t *testing.T) {
+	testGenerated(t, "time")
+}
+
+func TestInvalidTime(t *testing.T) {
+	msg := generationError(t, map[string]string{"pb/bad.proto": `syntax = "proto3";
+package pb;
+import "google/protobuf/timestamp.proto";
+import "validation.proto";
+message Bad {
+  google.protobuf.Timestamp at = 1 [(validation.field).ts_gt = "yesterday"];
+}
+`})
+	if !strings.Contains(msg, `field at: ts_gt "yesterday" is not an RFC 3339 time`) {
+		t.Errorf("unexpected error: %s", msg)
+	}
+}
@@ -64,7 +64,8 @@ func isWKTString(typeName string) bool {
 }
 
 func isWKTInt(typeName string) bool {
-	if strings.Contains(typeName, "Int") {
+	switch typeName {
+	case wktBasePath + "Int32Value", wktBasePath + "Int64Value", wktBasePath + "UInt32Value", wktBasePath + "UInt64Value":
 		return true
 	}
 	return false
@@ -79,3 +80,23 @@ func isWKTFloat(typeName string) bool {
 	}
 	return false
 }
+
+func isWKTBytes(typeName string) bool {
+	if typeName == wktBasePath+"BytesValue" {
+		return true
+	}
+	return false
+}
+
+func isWKTBool(typeName string) bool {
+	if typeName == wktBasePath+"BoolValue" {
+		return true
+	}
+	return false
+}
+
+// isWKTWrapper is true for the wrappers around a single scalar, which we validate through their Value field
+func isWKTWrapper(typeName string) bool {
+	return isWKTString(typeName) || isWKTFloat(typeName) || isWKTBytes(typeName) ||
+		isWKTInt(typeName) || isWKTBool(typeName)
+}
@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// UnpackAny unmarshals the message held in an Any from its type url and value, the message type has to be registered
+// with github.com/gogo/protobuf/proto, which its generated code does when the package is imported
+func UnpackAny(typeURL string, value []byte) (proto.Message, error) {
+	name := typeURL
+	if slash := strings.LastIndex(typeURL, "/"); slash >= 0 {
+		name = typeURL[slash+1:]
+	}
+	t := proto.MessageType(name)
+	if t == nil {
+		return nil, fmt.Errorf("unknown message type %q", name)
+	}
+	msg := reflect.New(t.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+func TestUnpackAny(t *testing.T) {
+	value, err := proto.Marshal(&types.StringValue{Value: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := UnpackAny("type.googleapis.com/google.protobuf.StringValue", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := msg.(*types.StringValue); !ok || s.Value != "a" {
+		t.Errorf("expected the StringValue back, got %#v", msg)
+	}
+
+	// the url is optional, only the name after the last / matters
+	if _, err := UnpackAny("google.protobuf.StringValue", value); err != nil {
+		t.Errorf("expected a bare name to work, got %v", err)
+	}
+	if _, err := UnpackAny("type.googleapis.com/pkg.Unknown", value); err == nil {
+		t.Error("expected an unknown type to fail")
+	}
+	if _, err := UnpackAny("type.googleapis.com/google.protobuf.StringValue", []byte{0xff}); err == nil {
+		t.Error("expected a bad value to fail")
+	}
+}
@@ -0,0 +1,111 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+)
+
+// EvalCEL runs the go code generated for a cel expression.  Anything that is an evaluation error in cel, like dividing
+// by zero or an index out of range, panics in go, so that is recovered and handed back as an error instead
+func EvalCEL(expr func() bool) (result bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return expr(), nil
+}
+
+// CELAnd is a && b the way cel does it.  A side that is false makes the result false even when the other side is an
+// error, so an error only comes out of it when neither side is false
+func CELAnd(a func() bool, b func() bool) bool {
+	aResult, aErr := EvalCEL(a)
+	if aErr == nil && !aResult {
+		return false
+	}
+	bResult, bErr := EvalCEL(b)
+	if bErr == nil && !bResult {
+		return false
+	}
+	if aErr != nil {
+		panic(aErr)
+	}
+	if bErr != nil {
+		panic(bErr)
+	}
+	return true
+}
+
+// CELOr is a || b the way cel does it, a side that is true wins over an error on the other side
+func CELOr(a func() bool, b func() bool) bool {
+	aResult, aErr := EvalCEL(a)
+	if aErr == nil && aResult {
+		return true
+	}
+	bResult, bErr := EvalCEL(b)
+	if bErr == nil && bResult {
+		return true
+	}
+	if aErr != nil {
+		panic(aErr)
+	}
+	if bErr != nil {
+		panic(bErr)
+	}
+	return false
+}
+
+// CELNotStrictlyFalse is true unless expr is false, an error counts as true.  The all and exists macros use it to stop
+// looping once their result is decided
+func CELNotStrictlyFalse(expr func() bool) bool {
+	result, err := EvalCEL(expr)
+	return err != nil || result
+}
+
+// CELBool evaluates expr now and returns a func that gives back the result, or panics again with the error.  The all
+// and exists macros keep going after an error, since a later item can still decide the result, so they hold on to it
+// this way
+func CELBool(expr func() bool) func() bool {
+	result, err := EvalCEL(expr)
+	return func() bool {
+		if err != nil {
+			panic(err)
+		}
+		return result
+	}
+}
+
+// CELIntFromUint is int() of a uint, one that is too big for an int is an error in cel
+func CELIntFromUint(v uint64) int64 {
+	if v > math.MaxInt64 {
+		panic(fmt.Errorf("range error converting %d to int", v))
+	}
+	return int64(v)
+}
+
+// CELIntFromDouble is int() of a double, cel rounds it and errors when it doesn't fit
+func CELIntFromDouble(v float64) int64 {
+	rounded := math.Round(v)
+	// float64(math.MaxInt64) rounds up to 2^63, which is already too big
+	if math.IsNaN(v) || rounded >= math.MaxInt64 || rounded < math.MinInt64 {
+		panic(fmt.Errorf("range error converting %g to int", v))
+	}
+	return int64(rounded)
+}
+
+// CELUintFromInt is uint() of an int, a negative one is an error in cel
+func CELUintFromInt(v int64) uint64 {
+	if v < 0 {
+		panic(fmt.Errorf("range error converting %d to uint", v))
+	}
+	return uint64(v)
+}
+
+// CELUintFromDouble is uint() of a double, rounded like CELIntFromDouble
+func CELUintFromDouble(v float64) uint64 {
+	rounded := math.Round(v)
+	if math.IsNaN(v) || rounded >= math.MaxUint64 || rounded < 0 {
+		panic(fmt.Errorf("range error converting %g to uint", v))
+	}
+	return uint64(rounded)
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"math"
+	"testing"
+)
+
+func celTrue() bool  { return true }
+func celFalse() bool { return false }
+func celError() bool { panic("no such key") }
+
+func TestEvalCEL(t *testing.T) {
+	if result, err := EvalCEL(celTrue); !result || err != nil {
+		t.Errorf("expected true, got %v %v", result, err)
+	}
+	if _, err := EvalCEL(celError); err == nil || err.Error() != "no such key" {
+		t.Errorf("expected the panic as an error, got %v", err)
+	}
+	// a runtime panic, like dividing by zero, is an error too
+	zero := 0
+	if _, err := EvalCEL(func() bool { return 1/zero == 0 }); err == nil {
+		t.Error("expected dividing by zero to be an error")
+	}
+}
+
+func TestCELLogic(t *testing.T) {
+	for name, test := range map[string]struct {
+		expr  func() bool
+		want  bool
+		fails bool
+	}{
+		"true && true":    {expr: func() bool { return CELAnd(celTrue, celTrue) }, want: true},
+		"error && false":  {expr: func() bool { return CELAnd(celError, celFalse) }, want: false},
+		"false && error":  {expr: func() bool { return CELAnd(celFalse, celError) }, want: false},
+		"true && error":   {expr: func() bool { return CELAnd(celTrue, celError) }, fails: true},
+		"false || false":  {expr: func() bool { return CELOr(celFalse, celFalse) }, want: false},
+		"error || true":   {expr: func() bool { return CELOr(celError, celTrue) }, want: true},
+		"false || error":  {expr: func() bool { return CELOr(celFalse, celError) }, fails: true},
+		"not false":       {expr: func() bool { return CELNotStrictlyFalse(celFalse) }, want: false},
+		"not false error": {expr: func() bool { return CELNotStrictlyFalse(celError) }, want: true},
+		"bool kept":       {expr: CELBool(celTrue), want: true},
+		"bool error kept": {expr: CELBool(celError), fails: true},
+	} {
+		result, err := EvalCEL(test.expr)
+		if (err != nil) != test.fails || (err == nil && result != test.want) {
+			t.Errorf("%s: expected %v (error %v), got %v %v", name, test.want, test.fails, result, err)
+		}
+	}
+}
+
+func TestCELConversions(t *testing.T) {
+	for name, test := range map[string]struct {
+		expr  func() bool
+		fails bool
+	}{
+		"int from uint":          {expr: func() bool { return CELIntFromUint(5) == 5 }},
+		"int from big uint":      {expr: func() bool { return CELIntFromUint(math.MaxUint64) == 0 }, fails: true},
+		"int from double":        {expr: func() bool { return CELIntFromDouble(2.5) == 3 }},
+		"int from huge double":   {expr: func() bool { return CELIntFromDouble(math.MaxInt64) == 0 }, fails: true},
+		"int from NaN":           {expr: func() bool { return CELIntFromDouble(math.NaN()) == 0 }, fails: true},
+		"uint from int":          {expr: func() bool { return CELUintFromInt(5) == 5 }},
+		"uint from negative int": {expr: func() bool { return CELUintFromInt(-1) == 0 }, fails: true},
+		"uint from double":       {expr: func() bool { return CELUintFromDouble(1.4) == 1 }},
+		"uint from negative":     {expr: func() bool { return CELUintFromDouble(-1) == 0 }, fails: true},
+		"uint from huge double":  {expr: func() bool { return CELUintFromDouble(math.MaxUint64) == 0 }, fails: true},
+	} {
+		result, err := EvalCEL(test.expr)
+		if (err != nil) != test.fails || (err == nil && !result) {
+			t.Errorf("%s: expected error %v, got %v %v", name, test.fails, result, err)
+		}
+	}
+}
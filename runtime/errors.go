@@ -0,0 +1,78 @@
+package runtime
+
+// ValidationError describes a single failed validation, Errors will be populated when the field is a message (or a
+// repeated message) that had errors of its own.  Field is the proto name of the field in its own message while Path is
+// the full path to it from the message Validate was called on made of json names, i.e. inner.itemList[3].helloWorld
+//
+// Rule is a stable identifier for the rule that failed, i.e. string.min_len, so you don't need to match on
+// ErrorMessage.  Constraint is the value the rule was looking for as it is shown in ErrorMessage, and Actual is the value
+// the field had.  Both are left empty when they don't apply, like for a nested message
+type ValidationError struct {
+	Field        string
+	Path         string
+	ErrorMessage string
+	Rule         string
+	Constraint   string
+	Actual       interface{}
+	Errors       []*ValidationError
+}
+
+// ValidationErrors is what every generated Validate function hands back as its error
+type ValidationErrors struct {
+	Errors []*ValidationError
+}
+
+// Error will just return the first error we encountered, inspect the actual object for more details
+func (e *ValidationErrors) Error() string {
+	if e != nil && e.Errors != nil && len(e.Errors) >= 1 {
+		return e.Errors[0].ErrorMessage
+	}
+	return ""
+}
+
+// GetValidationErrors flattens the error tree and returns the fields and error messages as 2 slices
+func GetValidationErrors(err error) ([]string, []string) {
+	fields := []string{}
+	errorMessages := []string{}
+	for _, e := range flatten(err) {
+		fields = append(fields, e.Field)
+		errorMessages = append(errorMessages, e.ErrorMessage)
+	}
+	return fields, errorMessages
+}
+
+// GetValidationErrorPaths is the same as GetValidationErrors but returns the full path of each field
+func GetValidationErrorPaths(err error) ([]string, []string) {
+	paths := []string{}
+	errorMessages := []string{}
+	for _, e := range flatten(err) {
+		paths = append(paths, e.Path)
+		errorMessages = append(errorMessages, e.ErrorMessage)
+	}
+	return paths, errorMessages
+}
+
+// PrefixPaths puts prefix in front of the Path of every error in errs and everything nested under them, generated code
+// uses this when it adds the errors from a nested message
+func PrefixPaths(prefix string, errs []*ValidationError) {
+	for _, e := range errs {
+		e.Path = prefix + "." + e.Path
+		PrefixPaths(prefix, e.Errors)
+	}
+}
+
+// flatten walks the error tree breadth first
+func flatten(err error) []*ValidationError {
+	errors := []*ValidationError{}
+	if err != nil {
+		if verr, ok := err.(*ValidationErrors); ok {
+			errors = append(errors, verr.Errors...)
+			for i := 0; i < len(errors); i++ {
+				if len(errors[i].Errors) != 0 {
+					errors = append(errors, errors[i].Errors...)
+				}
+			}
+		}
+	}
+	return errors
+}
@@ -0,0 +1,68 @@
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func testErrors() *ValidationErrors {
+	return &ValidationErrors{Errors: []*ValidationError{
+		{Field: "name", Path: "name", ErrorMessage: "name is required"},
+		{Field: "inner", Path: "inner", ErrorMessage: "error in inner", Errors: []*ValidationError{
+			{Field: "items[1]", Path: "inner.items[1]", ErrorMessage: "items[1] is too long"},
+		}},
+		{Field: "count", Path: "count", ErrorMessage: "count must be greater than 1"},
+	}}
+}
+
+func TestValidationErrorsError(t *testing.T) {
+	if got := testErrors().Error(); got != "name is required" {
+		t.Errorf("expected the first error message, got %q", got)
+	}
+	var verr *ValidationErrors
+	if got := verr.Error(); got != "" {
+		t.Errorf("expected nil errors to be empty, got %q", got)
+	}
+	if got := (&ValidationErrors{}).Error(); got != "" {
+		t.Errorf("expected no errors to be empty, got %q", got)
+	}
+}
+
+func TestGetValidationErrors(t *testing.T) {
+	fields, messages := GetValidationErrors(testErrors())
+	// breadth first, nested errors come after every top level one
+	wantFields := []string{"name", "inner", "count", "items[1]"}
+	wantMessages := []string{"name is required", "error in inner", "count must be greater than 1", "items[1] is too long"}
+	if !reflect.DeepEqual(fields, wantFields) {
+		t.Errorf("expected fields %v, got %v", wantFields, fields)
+	}
+	if !reflect.DeepEqual(messages, wantMessages) {
+		t.Errorf("expected messages %v, got %v", wantMessages, messages)
+	}
+
+	paths, _ := GetValidationErrorPaths(testErrors())
+	wantPaths := []string{"name", "inner", "count", "inner.items[1]"}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("expected paths %v, got %v", wantPaths, paths)
+	}
+}
+
+func TestGetValidationErrorsOtherErrors(t *testing.T) {
+	for _, err := range []error{nil, errors.New("not from Validate")} {
+		fields, messages := GetValidationErrors(err)
+		if len(fields) != 0 || len(messages) != 0 {
+			t.Errorf("%v: expected nothing, got %v %v", err, fields, messages)
+		}
+	}
+}
+
+func TestPrefixPaths(t *testing.T) {
+	errs := testErrors().Errors
+	PrefixPaths("outer", errs)
+	paths := []string{errs[0].Path, errs[1].Path, errs[1].Errors[0].Path}
+	want := []string{"outer.name", "outer.inner", "outer.inner.items[1]"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("expected paths %v, got %v", want, paths)
+	}
+}
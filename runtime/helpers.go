@@ -0,0 +1,26 @@
+package runtime
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IsValidUUID uses github.com/google/uuid to check that u parses as a uuid
+func IsValidUUID(u string) bool {
+	_, err := uuid.Parse(u)
+	return err == nil
+}
+
+// IsValidEmail uses net/mail ParseAddress to check that e is an email address
+func IsValidEmail(e string) bool {
+	_, err := mail.ParseAddress(e)
+	return err == nil
+}
+
+// IsValidDate uses time.Parse to check that d is a date in the format f
+func IsValidDate(f string, d string) bool {
+	_, err := time.Parse(f, d)
+	return err == nil
+}
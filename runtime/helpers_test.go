@@ -0,0 +1,51 @@
+package runtime
+
+import "testing"
+
+func TestIsValidUUID(t *testing.T) {
+	cases := map[string]bool{
+		"e4eaaaf2-d142-11e1-b3e4-080027620cdd": true,
+		"E4EAAAF2-D142-11E1-B3E4-080027620CDD": true,
+		"e4eaaaf2-d142-11e1-b3e4":              false,
+		"not a uuid":                           false,
+		"":                                     false,
+	}
+	for u, want := range cases {
+		if got := IsValidUUID(u); got != want {
+			t.Errorf("IsValidUUID(%q) = %v, expected %v", u, got, want)
+		}
+	}
+}
+
+func TestIsValidEmail(t *testing.T) {
+	cases := map[string]bool{
+		"someone@example.com":           true,
+		"Someone <someone@example.com>": true,
+		"someone":                       false,
+		"@example.com":                  false,
+		"":                              false,
+	}
+	for e, want := range cases {
+		if got := IsValidEmail(e); got != want {
+			t.Errorf("IsValidEmail(%q) = %v, expected %v", e, got, want)
+		}
+	}
+}
+
+func TestIsValidDate(t *testing.T) {
+	cases := []struct {
+		format string
+		date   string
+		want   bool
+	}{
+		{"2006-01-02", "2019-08-21", true},
+		{"2006-01-02", "2019-02-30", false},
+		{"2006-01-02", "08/21/2019", false},
+		{"01/02/2006", "08/21/2019", true},
+	}
+	for _, c := range cases {
+		if got := IsValidDate(c.format, c.date); got != c.want {
+			t.Errorf("IsValidDate(%q, %q) = %v, expected %v", c.format, c.date, got, c.want)
+		}
+	}
+}
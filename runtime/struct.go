@@ -0,0 +1,172 @@
+package runtime
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// StructRules are the options for Struct, Value and ListValue fields, they apply at every level of the value.  Zero
+// values mean no limit.  Depth counts the structs and lists a value is nested in, so a top level Struct is 1 deep
+type StructRules struct {
+	MaxKeys    int64
+	KeyRegex   *regexp.Regexp
+	MaxDepth   int64
+	MaxListLen int64
+	Kinds      []string
+}
+
+// ValidateStruct checks a google.protobuf.Struct against rules.  field and path are the proto and json names of the
+// struct's field, they start the field and path of every error so they look like field[key][2]
+func ValidateStruct(field string, path string, msg proto.Message, rules StructRules) []*ValidationError {
+	w := &structWalker{field: field, path: path, rules: rules}
+	s, ok := msg.(*types.Struct)
+	if !ok {
+		s = &types.Struct{}
+		if err := convertStruct(msg, s); err != nil {
+			return []*ValidationError{w.newError("", "struct.invalid", "", nil, "{field} could not be read")}
+		}
+	}
+	w.structValue("", s, 1)
+	return w.errs
+}
+
+// ValidateValue checks a google.protobuf.Value against rules
+func ValidateValue(field string, path string, msg proto.Message, rules StructRules) []*ValidationError {
+	w := &structWalker{field: field, path: path, rules: rules}
+	v, ok := msg.(*types.Value)
+	if !ok {
+		v = &types.Value{}
+		if err := convertStruct(msg, v); err != nil {
+			return []*ValidationError{w.newError("", "struct.invalid", "", nil, "{field} could not be read")}
+		}
+	}
+	w.value("", v, 0)
+	return w.errs
+}
+
+// ValidateListValue checks a google.protobuf.ListValue against rules
+func ValidateListValue(field string, path string, msg proto.Message, rules StructRules) []*ValidationError {
+	w := &structWalker{field: field, path: path, rules: rules}
+	l, ok := msg.(*types.ListValue)
+	if !ok {
+		l = &types.ListValue{}
+		if err := convertStruct(msg, l); err != nil {
+			return []*ValidationError{w.newError("", "struct.invalid", "", nil, "{field} could not be read")}
+		}
+	}
+	w.listValue("", l, 1)
+	return w.errs
+}
+
+// convertStruct handles structs that weren't generated as gogo types, i.e. from github.com/golang/protobuf, the wire
+// format is the same so we can just marshal from one into the other
+func convertStruct(from proto.Message, to proto.Message) error {
+	b, err := proto.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, to)
+}
+
+// structWalker collects the errors for one value, at is where in the value we are, i.e. [key][2], and goes on the end
+// of the field and path the value was passed in with
+type structWalker struct {
+	field string
+	path  string
+	rules StructRules
+	errs  []*ValidationError
+}
+
+func (w *structWalker) value(at string, v *types.Value, depth int64) {
+	kind := valueKind(v)
+	if len(w.rules.Kinds) != 0 && !containsString(w.rules.Kinds, kind) {
+		w.errs = append(w.errs, w.newError(at, "struct.kinds", strings.Join(w.rules.Kinds, ", "), kind, "{field} must be one of {value}"))
+	}
+	switch k := v.GetKind().(type) {
+	case *types.Value_StructValue:
+		w.structValue(at, k.StructValue, depth+1)
+	case *types.Value_ListValue:
+		w.listValue(at, k.ListValue, depth+1)
+	}
+}
+
+func (w *structWalker) structValue(at string, s *types.Struct, depth int64) {
+	if w.rules.MaxDepth != 0 && depth > w.rules.MaxDepth {
+		w.errs = append(w.errs, w.newError(at, "struct.max_depth", strconv.FormatInt(w.rules.MaxDepth, 10), depth, "{field} must not be nested more than {value} deep"))
+		return
+	}
+	fields := s.GetFields()
+	if w.rules.MaxKeys != 0 && int64(len(fields)) > w.rules.MaxKeys {
+		w.errs = append(w.errs, w.newError(at, "struct.max_keys", strconv.FormatInt(w.rules.MaxKeys, 10), len(fields), "{field} must have no more than {value} keys"))
+	}
+	// map order is random, sort so the errors come back the same every time
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		keyAt := at + "[" + key + "]"
+		if w.rules.KeyRegex != nil && !w.rules.KeyRegex.MatchString(key) {
+			w.errs = append(w.errs, w.newError(keyAt, "struct.key_regex", w.rules.KeyRegex.String(), key, "{field} is not an allowed key, keys must match {value}"))
+		}
+		w.value(keyAt, fields[key], depth)
+	}
+}
+
+func (w *structWalker) listValue(at string, l *types.ListValue, depth int64) {
+	if w.rules.MaxDepth != 0 && depth > w.rules.MaxDepth {
+		w.errs = append(w.errs, w.newError(at, "struct.max_depth", strconv.FormatInt(w.rules.MaxDepth, 10), depth, "{field} must not be nested more than {value} deep"))
+		return
+	}
+	values := l.GetValues()
+	if w.rules.MaxListLen != 0 && int64(len(values)) > w.rules.MaxListLen {
+		w.errs = append(w.errs, w.newError(at, "struct.max_list_len", strconv.FormatInt(w.rules.MaxListLen, 10), len(values), "{field} must have no more than {value} items"))
+	}
+	for i, v := range values {
+		w.value(at+"["+strconv.Itoa(i)+"]", v, depth)
+	}
+}
+
+// valueKind is the name we use for the kind of v in the struct_kinds option, an unset kind is treated as null the same
+// way the json mapping does
+func valueKind(v *types.Value) string {
+	switch v.GetKind().(type) {
+	case *types.Value_NumberValue:
+		return "number"
+	case *types.Value_StringValue:
+		return "string"
+	case *types.Value_BoolValue:
+		return "bool"
+	case *types.Value_StructValue:
+		return "struct"
+	case *types.Value_ListValue:
+		return "list"
+	}
+	return "null"
+}
+
+func (w *structWalker) newError(at string, rule string, constraint string, actual interface{}, errorMsg string) *ValidationError {
+	return &ValidationError{
+		Field:        w.field + at,
+		Path:         w.path + at,
+		ErrorMessage: strings.NewReplacer("{field}", w.field+at, "{value}", constraint).Replace(errorMsg),
+		Rule:         rule,
+		Constraint:   constraint,
+		Actual:       actual,
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, val := range values {
+		if val == s {
+			return true
+		}
+	}
+	return false
+}
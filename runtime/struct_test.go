@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	golangstruct "github.com/golang/protobuf/ptypes/struct"
+)
+
+func TestValidateStruct(t *testing.T) {
+	s := &types.Struct{Fields: map[string]*types.Value{
+		"b": {Kind: &types.Value_ListValue{ListValue: &types.ListValue{Values: []*types.Value{
+			{Kind: &types.Value_NumberValue{NumberValue: 1}},
+			{Kind: &types.Value_BoolValue{BoolValue: true}},
+		}}}},
+		"A": {Kind: &types.Value_StringValue{StringValue: "a"}},
+	}}
+	rules := StructRules{KeyRegex: regexp.MustCompile("^[a-z]$"), Kinds: []string{"string", "list", "number"}}
+	errs := ValidateStruct("extra_data", "extraData", s, rules)
+
+	// keys are sorted, and the field and path go in front of every error
+	want := []*ValidationError{
+		{Field: "extra_data[A]", Path: "extraData[A]", Rule: "struct.key_regex", Constraint: "^[a-z]$", Actual: "A", ErrorMessage: "extra_data[A] is not an allowed key, keys must match ^[a-z]$"},
+		{Field: "extra_data[b][1]", Path: "extraData[b][1]", Rule: "struct.kinds", Constraint: "string, list, number", Actual: "bool", ErrorMessage: "extra_data[b][1] must be one of string, list, number"},
+	}
+	if !reflect.DeepEqual(errs, want) {
+		for _, e := range errs {
+			t.Errorf("got %+v", e)
+		}
+	}
+}
+
+func TestValidateStructDepth(t *testing.T) {
+	nested := &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{}}}
+	list := &types.Value{Kind: &types.Value_ListValue{ListValue: &types.ListValue{Values: []*types.Value{nested}}}}
+
+	if errs := ValidateValue("v", "v", list, StructRules{MaxDepth: 2}); len(errs) != 0 {
+		t.Errorf("expected a struct in a list to be 2 deep, got %v", errs[0].ErrorMessage)
+	}
+	errs := ValidateValue("v", "v", list, StructRules{MaxDepth: 1})
+	if len(errs) != 1 || errs[0].Field != "v[0]" || errs[0].Rule != "struct.max_depth" {
+		t.Errorf("expected the struct in the list to be too deep, got %v", errs)
+	}
+	errs = ValidateListValue("l", "l", list.GetListValue(), StructRules{MaxDepth: 1})
+	if len(errs) != 1 || errs[0].Field != "l[0]" {
+		t.Errorf("expected the struct in the list to be too deep, got %v", errs)
+	}
+}
+
+func TestValidateStructGolang(t *testing.T) {
+	// structs from github.com/golang/protobuf are converted through the wire format
+	s := &golangstruct.Struct{Fields: map[string]*golangstruct.Value{
+		"a": {Kind: &golangstruct.Value_NullValue{}},
+		"b": {Kind: &golangstruct.Value_NullValue{}},
+	}}
+	errs := ValidateStruct("s", "s", s, StructRules{MaxKeys: 1})
+	if len(errs) != 1 || errs[0].Rule != "struct.max_keys" || errs[0].Actual != 2 {
+		t.Errorf("expected too many keys, got %v", errs)
+	}
+}
@@ -0,0 +1,7 @@
+package runtime
+
+import "time"
+
+// Now is the clock the time relative rules (ts_lt_now, ts_gt_now and ts_within) compare against, swap it out in tests
+// so they don't depend on when they run
+var Now = time.Now
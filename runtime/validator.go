@@ -0,0 +1,13 @@
+package runtime
+
+// Validator is implemented by every message we generated a Validate func for
+type Validator interface {
+	Validate() error
+}
+
+// Normalizer is implemented by the messages that have transforms in their field options (trim, lc, uc, trim_strings,
+// transform_func) or contain a message that does.  Normalize applies them in place, Validate itself never changes the
+// message
+type Normalizer interface {
+	Normalize()
+}
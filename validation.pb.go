@@ -75,11 +75,121 @@ type FieldValidation struct {
 	// common option - a function name that you presumably defined in the same package space as the
 	// code we generate that will be called via m.Field = FuncName(m.Field) allowing for any custom transformation
 	TransformFunc *string `protobuf:"bytes,21,opt,name=transform_func,json=transformFunc" json:"transform_func,omitempty"`
-	// do not generate validation code for a field; field type may not be supported i.e. oneof
-	DoNotValidate        *bool    `protobuf:"varint,22,opt,name=do_not_validate,json=doNotValidate" json:"do_not_validate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// do not generate validation code for a field
+	DoNotValidate *bool `protobuf:"varint,22,opt,name=do_not_validate,json=doNotValidate" json:"do_not_validate,omitempty"`
+	// map options
+	// rules applied to every key in the map
+	MapKey *FieldValidation `protobuf:"bytes,23,opt,name=map_key,json=mapKey" json:"map_key,omitempty"`
+	// rules applied to every value in the map, message values will have Validate called on them regardless
+	MapValue *FieldValidation `protobuf:"bytes,24,opt,name=map_value,json=mapValue" json:"map_value,omitempty"`
+	// map must have at least this many pairs
+	MapMinPairs *int64 `protobuf:"varint,25,opt,name=map_min_pairs,json=mapMinPairs" json:"map_min_pairs,omitempty"`
+	// map must have no more than this many pairs
+	MapMaxPairs *int64 `protobuf:"varint,26,opt,name=map_max_pairs,json=mapMaxPairs" json:"map_max_pairs,omitempty"`
+	// enum options
+	// value must be one of the values defined in the enum
+	DefinedOnly *bool `protobuf:"varint,27,opt,name=defined_only,json=definedOnly" json:"defined_only,omitempty"`
+	// value must be one of these, each entry can be the name or the number of an enum value
+	EnumIn []string `protobuf:"bytes,28,rep,name=enum_in,json=enumIn" json:"enum_in,omitempty"`
+	// value must not be any of these, each entry can be the name or the number of an enum value
+	EnumNotIn []string `protobuf:"bytes,29,rep,name=enum_not_in,json=enumNotIn" json:"enum_not_in,omitempty"`
+	// bytes options
+	// value must be at least this many bytes
+	BytesMinLen *int64 `protobuf:"varint,30,opt,name=bytes_min_len,json=bytesMinLen" json:"bytes_min_len,omitempty"`
+	// value must be at most this many bytes
+	BytesMaxLen *int64 `protobuf:"varint,31,opt,name=bytes_max_len,json=bytesMaxLen" json:"bytes_max_len,omitempty"`
+	// value must be exactly this many bytes
+	BytesEqLen *int64 `protobuf:"varint,32,opt,name=bytes_eq_len,json=bytesEqLen" json:"bytes_eq_len,omitempty"`
+	// value must start with these bytes
+	BytesPrefix []byte `protobuf:"bytes,33,opt,name=bytes_prefix,json=bytesPrefix" json:"bytes_prefix,omitempty"`
+	// value must end with these bytes
+	BytesSuffix []byte `protobuf:"bytes,34,opt,name=bytes_suffix,json=bytesSuffix" json:"bytes_suffix,omitempty"`
+	// value must contain these bytes
+	BytesContains []byte `protobuf:"bytes,35,opt,name=bytes_contains,json=bytesContains" json:"bytes_contains,omitempty"`
+	// value must match this regex
+	BytesRegex *string `protobuf:"bytes,36,opt,name=bytes_regex,json=bytesRegex" json:"bytes_regex,omitempty"`
+	// repeated options, these apply to the list as a whole while other options apply to each item in it
+	// list must have at least this many items
+	MinItems *int64 `protobuf:"varint,37,opt,name=min_items,json=minItems" json:"min_items,omitempty"`
+	// list must have no more than this many items
+	MaxItems *int64 `protobuf:"varint,38,opt,name=max_items,json=maxItems" json:"max_items,omitempty"`
+	// every item in a list of scalars must be unique
+	Unique *bool `protobuf:"varint,39,opt,name=unique" json:"unique,omitempty"`
+	// every message in the list must have a unique value for this field of the message
+	UniqueBy *string `protobuf:"bytes,40,opt,name=unique_by,json=uniqueBy" json:"unique_by,omitempty"`
+	// message options
+	// a message field (including well known types like Timestamp) must be set
+	Required *bool `protobuf:"varint,41,opt,name=required" json:"required,omitempty"`
+	// timestamp options, times are RFC 3339 and durations are in go's time.ParseDuration format, i.e. 1h30m
+	// value must be before this time
+	TsLt *string `protobuf:"bytes,42,opt,name=ts_lt,json=tsLt" json:"ts_lt,omitempty"`
+	// value must be after this time
+	TsGt *string `protobuf:"bytes,43,opt,name=ts_gt,json=tsGt" json:"ts_gt,omitempty"`
+	// value must be before now
+	TsLtNow *bool `protobuf:"varint,44,opt,name=ts_lt_now,json=tsLtNow" json:"ts_lt_now,omitempty"`
+	// value must be after now
+	TsGtNow *bool `protobuf:"varint,45,opt,name=ts_gt_now,json=tsGtNow" json:"ts_gt_now,omitempty"`
+	// value must be within this duration of now, either side of it
+	TsWithin *string `protobuf:"bytes,46,opt,name=ts_within,json=tsWithin" json:"ts_within,omitempty"`
+	// duration options, also in go's time.ParseDuration format
+	// value must be no more than this
+	DurationLte *string `protobuf:"bytes,47,opt,name=duration_lte,json=durationLte" json:"duration_lte,omitempty"`
+	// value must be at least this
+	DurationGte *string `protobuf:"bytes,48,opt,name=duration_gte,json=durationGte" json:"duration_gte,omitempty"`
+	// bool options
+	// value must be this, on a BoolValue the wrapper must also be set
+	BoolConst *bool `protobuf:"varint,49,opt,name=bool_const,json=boolConst" json:"bool_const,omitempty"`
+	// any options, type urls are compared as is, i.e. type.googleapis.com/package.Message
+	// type url must be one of these
+	AnyIn []string `protobuf:"bytes,50,rep,name=any_in,json=anyIn" json:"any_in,omitempty"`
+	// type url must not be any of these
+	AnyNotIn []string `protobuf:"bytes,51,rep,name=any_not_in,json=anyNotIn" json:"any_not_in,omitempty"`
+	// unpacks the message in the any and calls its Validate, if it has one
+	AnyValidate *bool `protobuf:"varint,52,opt,name=any_validate,json=anyValidate" json:"any_validate,omitempty"`
+	// struct options, these work on Struct, Value and ListValue fields and apply at every level of them
+	// a struct can have no more than this many keys
+	StructMaxKeys *int64 `protobuf:"varint,53,opt,name=struct_max_keys,json=structMaxKeys" json:"struct_max_keys,omitempty"`
+	// every key must match this regex
+	StructKeyRegex *string `protobuf:"bytes,54,opt,name=struct_key_regex,json=structKeyRegex" json:"struct_key_regex,omitempty"`
+	// structs and lists can't be nested deeper than this, a top level Struct is 1 deep
+	StructMaxDepth *int64 `protobuf:"varint,55,opt,name=struct_max_depth,json=structMaxDepth" json:"struct_max_depth,omitempty"`
+	// a list can have no more than this many items
+	StructMaxListLen *int64 `protobuf:"varint,56,opt,name=struct_max_list_len,json=structMaxListLen" json:"struct_max_list_len,omitempty"`
+	// every value must be one of these kinds: null, number, string, bool, struct, list
+	StructKinds []string `protobuf:"bytes,57,rep,name=struct_kinds,json=structKinds" json:"struct_kinds,omitempty"`
+	// field mask options
+	// every path must be a field of this message, given with its package, i.e. package.Message
+	FieldMaskOf *string `protobuf:"bytes,58,opt,name=field_mask_of,json=fieldMaskOf" json:"field_mask_of,omitempty"`
+	// these paths, and anything under them, are not allowed
+	FieldMaskDisallow []string `protobuf:"bytes,59,rep,name=field_mask_disallow,json=fieldMaskDisallow" json:"field_mask_disallow,omitempty"`
+	// cel options
+	// cel expressions that must be true, this in the expression is the value of the field
+	Cel []*CelValidation `protobuf:"bytes,60,rep,name=cel" json:"cel,omitempty"`
+	// cross field options, these compare the value to another field of the same message, named as it is in the proto
+	// file.  Ints, floats and strings can use all of them, bools, bytes and enums (of the same enum) only eq and ne
+	// value must equal this field
+	EqField *string `protobuf:"bytes,61,opt,name=eq_field,json=eqField" json:"eq_field,omitempty"`
+	// value must not equal this field
+	NeField *string `protobuf:"bytes,62,opt,name=ne_field,json=neField" json:"ne_field,omitempty"`
+	// value must be greater than this field
+	GtField *string `protobuf:"bytes,63,opt,name=gt_field,json=gtField" json:"gt_field,omitempty"`
+	// value must be greater than or equal to this field
+	GteField *string `protobuf:"bytes,64,opt,name=gte_field,json=gteField" json:"gte_field,omitempty"`
+	// value must be less than this field
+	LtField *string `protobuf:"bytes,65,opt,name=lt_field,json=ltField" json:"lt_field,omitempty"`
+	// value must be less than or equal to this field
+	LteField *string `protobuf:"bytes,66,opt,name=lte_field,json=lteField" json:"lte_field,omitempty"`
+	// conditional options, a field counts as set when it isn't its default value (or nil / empty for messages, lists and
+	// maps), for a oneof field it has to be the case that is set
+	// field must be set when any of these conditions are true
+	RequiredIf []*FieldCondition `protobuf:"bytes,67,rep,name=required_if,json=requiredIf" json:"required_if,omitempty"`
+	// field must be set unless one of these conditions is true
+	RequiredUnless []*FieldCondition `protobuf:"bytes,68,rep,name=required_unless,json=requiredUnless" json:"required_unless,omitempty"`
+	// field must not be set when any of these conditions are true
+	ForbiddenIf          []*FieldCondition `protobuf:"bytes,69,rep,name=forbidden_if,json=forbiddenIf" json:"forbidden_if,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *FieldValidation) Reset()         { *m = FieldValidation{} }
@@ -269,14 +379,347 @@ func (m *FieldValidation) GetDoNotValidate() bool {
 	return false
 }
 
+func (m *FieldValidation) GetMapKey() *FieldValidation {
+	if m != nil {
+		return m.MapKey
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetMapValue() *FieldValidation {
+	if m != nil {
+		return m.MapValue
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetMapMinPairs() int64 {
+	if m != nil && m.MapMinPairs != nil {
+		return *m.MapMinPairs
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetMapMaxPairs() int64 {
+	if m != nil && m.MapMaxPairs != nil {
+		return *m.MapMaxPairs
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetDefinedOnly() bool {
+	if m != nil && m.DefinedOnly != nil {
+		return *m.DefinedOnly
+	}
+	return false
+}
+
+func (m *FieldValidation) GetEnumIn() []string {
+	if m != nil {
+		return m.EnumIn
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetEnumNotIn() []string {
+	if m != nil {
+		return m.EnumNotIn
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetBytesMinLen() int64 {
+	if m != nil && m.BytesMinLen != nil {
+		return *m.BytesMinLen
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetBytesMaxLen() int64 {
+	if m != nil && m.BytesMaxLen != nil {
+		return *m.BytesMaxLen
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetBytesEqLen() int64 {
+	if m != nil && m.BytesEqLen != nil {
+		return *m.BytesEqLen
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetBytesPrefix() []byte {
+	if m != nil {
+		return m.BytesPrefix
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetBytesSuffix() []byte {
+	if m != nil {
+		return m.BytesSuffix
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetBytesContains() []byte {
+	if m != nil {
+		return m.BytesContains
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetBytesRegex() string {
+	if m != nil && m.BytesRegex != nil {
+		return *m.BytesRegex
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetMinItems() int64 {
+	if m != nil && m.MinItems != nil {
+		return *m.MinItems
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetMaxItems() int64 {
+	if m != nil && m.MaxItems != nil {
+		return *m.MaxItems
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetUnique() bool {
+	if m != nil && m.Unique != nil {
+		return *m.Unique
+	}
+	return false
+}
+
+func (m *FieldValidation) GetUniqueBy() string {
+	if m != nil && m.UniqueBy != nil {
+		return *m.UniqueBy
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetRequired() bool {
+	if m != nil && m.Required != nil {
+		return *m.Required
+	}
+	return false
+}
+
+func (m *FieldValidation) GetTsLt() string {
+	if m != nil && m.TsLt != nil {
+		return *m.TsLt
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetTsGt() string {
+	if m != nil && m.TsGt != nil {
+		return *m.TsGt
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetTsLtNow() bool {
+	if m != nil && m.TsLtNow != nil {
+		return *m.TsLtNow
+	}
+	return false
+}
+
+func (m *FieldValidation) GetTsGtNow() bool {
+	if m != nil && m.TsGtNow != nil {
+		return *m.TsGtNow
+	}
+	return false
+}
+
+func (m *FieldValidation) GetTsWithin() string {
+	if m != nil && m.TsWithin != nil {
+		return *m.TsWithin
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetDurationLte() string {
+	if m != nil && m.DurationLte != nil {
+		return *m.DurationLte
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetDurationGte() string {
+	if m != nil && m.DurationGte != nil {
+		return *m.DurationGte
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetBoolConst() bool {
+	if m != nil && m.BoolConst != nil {
+		return *m.BoolConst
+	}
+	return false
+}
+
+func (m *FieldValidation) GetAnyIn() []string {
+	if m != nil {
+		return m.AnyIn
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetAnyNotIn() []string {
+	if m != nil {
+		return m.AnyNotIn
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetAnyValidate() bool {
+	if m != nil && m.AnyValidate != nil {
+		return *m.AnyValidate
+	}
+	return false
+}
+
+func (m *FieldValidation) GetStructMaxKeys() int64 {
+	if m != nil && m.StructMaxKeys != nil {
+		return *m.StructMaxKeys
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetStructKeyRegex() string {
+	if m != nil && m.StructKeyRegex != nil {
+		return *m.StructKeyRegex
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetStructMaxDepth() int64 {
+	if m != nil && m.StructMaxDepth != nil {
+		return *m.StructMaxDepth
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetStructMaxListLen() int64 {
+	if m != nil && m.StructMaxListLen != nil {
+		return *m.StructMaxListLen
+	}
+	return 0
+}
+
+func (m *FieldValidation) GetStructKinds() []string {
+	if m != nil {
+		return m.StructKinds
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetFieldMaskOf() string {
+	if m != nil && m.FieldMaskOf != nil {
+		return *m.FieldMaskOf
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetFieldMaskDisallow() []string {
+	if m != nil {
+		return m.FieldMaskDisallow
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetCel() []*CelValidation {
+	if m != nil {
+		return m.Cel
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetEqField() string {
+	if m != nil && m.EqField != nil {
+		return *m.EqField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetNeField() string {
+	if m != nil && m.NeField != nil {
+		return *m.NeField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetGtField() string {
+	if m != nil && m.GtField != nil {
+		return *m.GtField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetGteField() string {
+	if m != nil && m.GteField != nil {
+		return *m.GteField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetLtField() string {
+	if m != nil && m.LtField != nil {
+		return *m.LtField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetLteField() string {
+	if m != nil && m.LteField != nil {
+		return *m.LteField
+	}
+	return ""
+}
+
+func (m *FieldValidation) GetRequiredIf() []*FieldCondition {
+	if m != nil {
+		return m.RequiredIf
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetRequiredUnless() []*FieldCondition {
+	if m != nil {
+		return m.RequiredUnless
+	}
+	return nil
+}
+
+func (m *FieldValidation) GetForbiddenIf() []*FieldCondition {
+	if m != nil {
+		return m.ForbiddenIf
+	}
+	return nil
+}
+
 type MessageValidation struct {
 	// returns right away after the first error instead of the default of validating all fields
 	ReturnOnError *bool `protobuf:"varint,1,opt,name=return_on_error,json=returnOnError" json:"return_on_error,omitempty"`
 	// uses strings.Trim on all strings in this message
-	TrimStrings          *bool    `protobuf:"varint,2,opt,name=trim_strings,json=trimStrings" json:"trim_strings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TrimStrings *bool `protobuf:"varint,2,opt,name=trim_strings,json=trimStrings" json:"trim_strings,omitempty"`
+	// has Validate call Normalize first, which is how transforms used to work before they moved to Normalize
+	NormalizeInValidate *bool `protobuf:"varint,3,opt,name=normalize_in_validate,json=normalizeInValidate" json:"normalize_in_validate,omitempty"`
+	// cel expressions that must be true, this in the expression is the message
+	Cel                  []*CelValidation `protobuf:"bytes,4,rep,name=cel" json:"cel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MessageValidation) Reset()         { *m = MessageValidation{} }
@@ -326,89 +769,344 @@ func (m *MessageValidation) GetTrimStrings() bool {
 	return false
 }
 
-var E_Field = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*FieldValidation)(nil),
-	Field:         61032,
-	Name:          "validation.field",
-	Tag:           "bytes,61032,opt,name=field",
-	Filename:      "validation.proto",
+func (m *MessageValidation) GetNormalizeInValidate() bool {
+	if m != nil && m.NormalizeInValidate != nil {
+		return *m.NormalizeInValidate
+	}
+	return false
 }
 
-var E_Message = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.MessageOptions)(nil),
-	ExtensionType: (*MessageValidation)(nil),
-	Field:         61032,
-	Name:          "validation.message",
-	Tag:           "bytes,61032,opt,name=message",
-	Filename:      "validation.proto",
+func (m *MessageValidation) GetCel() []*CelValidation {
+	if m != nil {
+		return m.Cel
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*FieldValidation)(nil), "validation.FieldValidation")
-	proto.RegisterType((*MessageValidation)(nil), "validation.MessageValidation")
-	proto.RegisterExtension(E_Field)
-	proto.RegisterExtension(E_Message)
+// CelValidation is a cel expression checked against the message when the code is generated and run by Validate
+type CelValidation struct {
+	// the expression, it must return a bool
+	Expression *string `protobuf:"bytes,1,opt,name=expression" json:"expression,omitempty"`
+	// error message when the expression is false, {field} and {value} (the expression) work the same as in error
+	Message *string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	// names the rule, errors use cel.<id> as their Rule instead of just cel
+	Id                   *string  `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("validation.proto", fileDescriptor_bfc2ab0b60b7792f) }
+func (m *CelValidation) Reset()         { *m = CelValidation{} }
+func (m *CelValidation) String() string { return proto.CompactTextString(m) }
+func (*CelValidation) ProtoMessage()    {}
+func (*CelValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfc2ab0b60b7792f, []int{2}
+}
+func (m *CelValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CelValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CelValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CelValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CelValidation.Merge(m, src)
+}
+func (m *CelValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CelValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CelValidation.DiscardUnknown(m)
+}
 
-var fileDescriptor_bfc2ab0b60b7792f = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0x4d, 0x6f, 0x13, 0x31,
-	0x14, 0xd4, 0xb6, 0x4d, 0x36, 0x7d, 0x69, 0x3e, 0x6a, 0x12, 0x30, 0xad, 0x1a, 0x42, 0x25, 0xaa,
-	0x9c, 0x52, 0xe0, 0x80, 0x50, 0x8f, 0x88, 0x14, 0x21, 0x15, 0x2a, 0x2d, 0x82, 0x43, 0x0f, 0x58,
-	0x66, 0xf7, 0x65, 0xb1, 0xb4, 0x6b, 0x27, 0xb6, 0x17, 0x85, 0x7f, 0xc8, 0x91, 0x1f, 0xc0, 0x01,
-	0x85, 0x0b, 0x3f, 0x03, 0xd9, 0x4e, 0x42, 0xd4, 0xde, 0x3c, 0x33, 0xde, 0xd9, 0x79, 0x4f, 0x63,
-	0xe8, 0x7e, 0xe3, 0x85, 0xc8, 0xb8, 0x15, 0x4a, 0x8e, 0x67, 0x5a, 0x59, 0x45, 0xe0, 0x3f, 0x73,
-	0x34, 0xcc, 0x95, 0xca, 0x0b, 0x3c, 0xf7, 0xca, 0x97, 0x6a, 0x7a, 0x9e, 0xa1, 0x49, 0xb5, 0x98,
-	0x59, 0xa5, 0xc3, 0xed, 0xd3, 0x3f, 0x7b, 0xd0, 0xb9, 0x14, 0x58, 0x64, 0x9f, 0x36, 0x5f, 0x91,
-	0x11, 0x74, 0xa5, 0xb2, 0x0c, 0xcb, 0x99, 0xfd, 0xce, 0x8c, 0xd5, 0x42, 0xe6, 0x34, 0x1a, 0x46,
-	0xa3, 0x46, 0xd2, 0x96, 0xca, 0x4e, 0x1c, 0xfd, 0xc1, 0xb3, 0x84, 0x42, 0x5c, 0x72, 0x9b, 0x7e,
-	0x45, 0x43, 0x77, 0x86, 0xd1, 0x68, 0x3f, 0x59, 0x43, 0x72, 0x04, 0x8d, 0x54, 0x49, 0xcb, 0x85,
-	0x34, 0x74, 0xd7, 0x4b, 0x1b, 0x4c, 0x7a, 0x50, 0xd3, 0x98, 0xe3, 0x82, 0xee, 0x79, 0x21, 0x00,
-	0xf2, 0x00, 0x62, 0x21, 0x2d, 0x2b, 0x2c, 0xd2, 0xda, 0x30, 0x1a, 0xed, 0x26, 0x75, 0x21, 0xed,
-	0x95, 0xc5, 0xb5, 0x90, 0x5b, 0xa4, 0xf5, 0x8d, 0xf0, 0xc6, 0x22, 0xe9, 0x83, 0x3b, 0x31, 0x9c,
-	0xd3, 0xd8, 0xf3, 0x35, 0x21, 0xed, 0x64, 0x4e, 0x8e, 0x61, 0x7f, 0x5a, 0x28, 0x1e, 0xac, 0x1a,
-	0xc3, 0x68, 0x14, 0x25, 0x0d, 0x4f, 0x38, 0xb3, 0x8d, 0xe8, 0xec, 0xf6, 0xb7, 0x44, 0x67, 0xf8,
-	0x10, 0xc2, 0xd9, 0x59, 0x82, 0xd7, 0x62, 0x8f, 0x27, 0x73, 0x17, 0xa2, 0x14, 0x92, 0x15, 0x28,
-	0x69, 0x33, 0x84, 0x28, 0x85, 0xbc, 0x42, 0xe9, 0x05, 0xbe, 0xf0, 0xc2, 0xc1, 0x4a, 0xe0, 0x0b,
-	0x27, 0xf4, 0xa1, 0x8e, 0x73, 0xcf, 0xb7, 0x42, 0x3a, 0x9c, 0x3b, 0xba, 0x07, 0x35, 0xd4, 0x5a,
-	0x69, 0xda, 0x0e, 0xc3, 0x7b, 0xe0, 0x67, 0x34, 0xac, 0xaa, 0x44, 0x46, 0x3b, 0x7e, 0xd3, 0x75,
-	0x61, 0x3e, 0x56, 0x22, 0x73, 0x91, 0x84, 0x61, 0x58, 0x72, 0x51, 0xd0, 0xae, 0x57, 0x62, 0x61,
-	0x26, 0x0e, 0x92, 0x33, 0xe8, 0x08, 0xc3, 0x84, 0x51, 0x2f, 0x5f, 0x3c, 0x7d, 0xc6, 0x32, 0x6e,
-	0x91, 0x1e, 0xfa, 0x1b, 0x2d, 0x61, 0xde, 0x06, 0xf6, 0x35, 0xb7, 0x48, 0x08, 0xec, 0x59, 0x2d,
-	0x4a, 0x4a, 0xbc, 0xe8, 0xcf, 0xa4, 0x0d, 0x3b, 0x45, 0x4a, 0xef, 0x79, 0x66, 0xa7, 0x48, 0x1d,
-	0xae, 0x52, 0xda, 0x0b, 0xb8, 0x4a, 0xc9, 0x13, 0x68, 0x5b, 0xcd, 0xa5, 0x99, 0x2a, 0x5d, 0xb2,
-	0x69, 0x25, 0x53, 0xda, 0xf7, 0x71, 0x5b, 0x1b, 0xf6, 0xb2, 0x92, 0xa9, 0x8b, 0x90, 0x29, 0xe6,
-	0xca, 0xb2, 0x2a, 0x1d, 0xd2, 0xfb, 0x21, 0x42, 0xa6, 0xde, 0x2b, 0xbb, 0xea, 0x14, 0x9e, 0x7e,
-	0x86, 0xc3, 0x77, 0x68, 0x0c, 0xcf, 0x71, 0xab, 0x66, 0x67, 0xd0, 0xd1, 0x68, 0x2b, 0x2d, 0x99,
-	0x92, 0x2c, 0xec, 0x24, 0xb4, 0xac, 0x15, 0xe8, 0x6b, 0x39, 0xf1, 0xbb, 0x79, 0x0c, 0x07, 0x2e,
-	0xf3, 0xaa, 0x89, 0xa1, 0x69, 0x8d, 0xa4, 0xe9, 0xb8, 0x50, 0x43, 0x73, 0x91, 0x40, 0x6d, 0xea,
-	0x4a, 0x4c, 0x4e, 0xc6, 0xa1, 0xf1, 0xe3, 0x75, 0xe3, 0xc7, 0xbe, 0xdc, 0xd7, 0x33, 0xf7, 0x47,
-	0x43, 0xff, 0xfe, 0x72, 0x5d, 0x6c, 0x3e, 0x3f, 0x1e, 0x6f, 0x3d, 0x9b, 0x5b, 0xf5, 0x4f, 0x82,
-	0xd5, 0xc5, 0x0d, 0xc4, 0x65, 0xc8, 0x4c, 0x1e, 0xdd, 0x71, 0x5d, 0x4d, 0x73, 0xdb, 0xf7, 0x64,
-	0xdb, 0xf7, 0xce, 0xc4, 0xc9, 0xda, 0xf0, 0x15, 0xfd, 0xb1, 0x1c, 0x44, 0x3f, 0x97, 0x83, 0xe8,
-	0xf7, 0x72, 0x10, 0xdd, 0x6c, 0xbd, 0xd8, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x3e, 0x44,
-	0x2e, 0xd0, 0x03, 0x00, 0x00,
+var xxx_messageInfo_CelValidation proto.InternalMessageInfo
+
+func (m *CelValidation) GetExpression() string {
+	if m != nil && m.Expression != nil {
+		return *m.Expression
+	}
+	return ""
 }
 
-func (m *FieldValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CelValidation) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FieldValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CelValidation) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
 }
 
-func (m *FieldValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+// FieldCondition is true when another scalar or enum field of the same message has the given value
+type FieldCondition struct {
+	// name of the other field as it is in the proto file
+	Field *string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	// the value written as it would be in a proto file: a number, true / false, a string (without quotes) or the name of
+	// an enum value
+	Value                *string  `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldCondition) Reset()         { *m = FieldCondition{} }
+func (m *FieldCondition) String() string { return proto.CompactTextString(m) }
+func (*FieldCondition) ProtoMessage()    {}
+func (*FieldCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfc2ab0b60b7792f, []int{3}
+}
+func (m *FieldCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldCondition.Merge(m, src)
+}
+func (m *FieldCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldCondition proto.InternalMessageInfo
+
+func (m *FieldCondition) GetField() string {
+	if m != nil && m.Field != nil {
+		return *m.Field
+	}
+	return ""
+}
+
+func (m *FieldCondition) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+type OneofValidation struct {
+	// one of the fields in the oneof must be set
+	Required             *bool    `protobuf:"varint,1,opt,name=required" json:"required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OneofValidation) Reset()         { *m = OneofValidation{} }
+func (m *OneofValidation) String() string { return proto.CompactTextString(m) }
+func (*OneofValidation) ProtoMessage()    {}
+func (*OneofValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfc2ab0b60b7792f, []int{4}
+}
+func (m *OneofValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OneofValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OneofValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OneofValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OneofValidation.Merge(m, src)
+}
+func (m *OneofValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *OneofValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OneofValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OneofValidation proto.InternalMessageInfo
+
+func (m *OneofValidation) GetRequired() bool {
+	if m != nil && m.Required != nil {
+		return *m.Required
+	}
+	return false
+}
+
+var E_Field = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*FieldValidation)(nil),
+	Field:         61032,
+	Name:          "validation.field",
+	Tag:           "bytes,61032,opt,name=field",
+	Filename:      "validation.proto",
+}
+
+var E_Message = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*MessageValidation)(nil),
+	Field:         61032,
+	Name:          "validation.message",
+	Tag:           "bytes,61032,opt,name=message",
+	Filename:      "validation.proto",
+}
+
+var E_Oneof = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.OneofOptions)(nil),
+	ExtensionType: (*OneofValidation)(nil),
+	Field:         61032,
+	Name:          "validation.oneof",
+	Tag:           "bytes,61032,opt,name=oneof",
+	Filename:      "validation.proto",
+}
+
+func init() {
+	proto.RegisterType((*FieldValidation)(nil), "validation.FieldValidation")
+	proto.RegisterType((*MessageValidation)(nil), "validation.MessageValidation")
+	proto.RegisterType((*CelValidation)(nil), "validation.CelValidation")
+	proto.RegisterType((*FieldCondition)(nil), "validation.FieldCondition")
+	proto.RegisterType((*OneofValidation)(nil), "validation.OneofValidation")
+	proto.RegisterExtension(E_Field)
+	proto.RegisterExtension(E_Message)
+	proto.RegisterExtension(E_Oneof)
+}
+
+func init() { proto.RegisterFile("validation.proto", fileDescriptor_bfc2ab0b60b7792f) }
+
+var fileDescriptor_bfc2ab0b60b7792f = []byte{
+	// 1428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5d, 0x53, 0x1b, 0xc7,
+	0x12, 0x2d, 0xf1, 0x29, 0x8d, 0x40, 0xc0, 0x60, 0xec, 0x31, 0x18, 0x2c, 0xb8, 0xd7, 0x5c, 0xdd,
+	0xeb, 0x0b, 0xd8, 0xc4, 0x76, 0x1c, 0x7f, 0xe4, 0xc3, 0x80, 0x5d, 0x2a, 0x83, 0x71, 0xc9, 0x65,
+	0xa7, 0xe2, 0x97, 0xad, 0x45, 0x3b, 0x12, 0x53, 0xec, 0xce, 0x48, 0x3b, 0xb3, 0x46, 0x9b, 0x3f,
+	0x97, 0xd7, 0x3c, 0xe6, 0x07, 0xe4, 0x21, 0xe5, 0xa7, 0x3c, 0xe4, 0x47, 0xa4, 0xba, 0x7b, 0x77,
+	0x25, 0xec, 0x54, 0xc8, 0xdb, 0xf6, 0x39, 0xa7, 0x7b, 0x7b, 0x66, 0xcf, 0xf4, 0x0e, 0x9b, 0xff,
+	0xe0, 0x87, 0x2a, 0xf0, 0x9d, 0x32, 0x7a, 0xbb, 0x17, 0x1b, 0x67, 0x38, 0x1b, 0x22, 0xcb, 0xf5,
+	0xae, 0x31, 0xdd, 0x50, 0xee, 0x20, 0x73, 0x92, 0x74, 0x76, 0x02, 0x69, 0xdb, 0xb1, 0xea, 0x39,
+	0x13, 0x93, 0x7a, 0xe3, 0x8f, 0x05, 0x36, 0xf7, 0x5c, 0xc9, 0x30, 0x78, 0x57, 0x64, 0xf1, 0x06,
+	0x9b, 0xd7, 0xc6, 0x79, 0x32, 0xea, 0xb9, 0xd4, 0xb3, 0x2e, 0x56, 0xba, 0x2b, 0x4a, 0xf5, 0x52,
+	0xa3, 0xdc, 0xaa, 0x69, 0xe3, 0x0e, 0x00, 0x7e, 0x83, 0x28, 0x17, 0x6c, 0x3a, 0xf2, 0x5d, 0xfb,
+	0x54, 0x5a, 0x31, 0x56, 0x2f, 0x35, 0x2a, 0xad, 0x3c, 0xe4, 0xcb, 0xac, 0xdc, 0x36, 0xda, 0xf9,
+	0x4a, 0x5b, 0x31, 0x8e, 0x54, 0x11, 0xf3, 0x2b, 0x6c, 0x32, 0x96, 0x5d, 0x39, 0x10, 0x13, 0x48,
+	0x50, 0xc0, 0xaf, 0xb1, 0x69, 0xa5, 0x9d, 0x17, 0x3a, 0x29, 0x26, 0xeb, 0xa5, 0xc6, 0x78, 0x6b,
+	0x4a, 0x69, 0x77, 0xe8, 0x64, 0x4e, 0x74, 0x9d, 0x14, 0x53, 0x05, 0xf1, 0xc2, 0x49, 0xbe, 0xc4,
+	0xe0, 0xc9, 0x93, 0x7d, 0x31, 0x8d, 0xf8, 0xa4, 0xd2, 0xee, 0xa0, 0xcf, 0x57, 0x58, 0xa5, 0x13,
+	0x1a, 0x9f, 0x4a, 0x95, 0xeb, 0xa5, 0x46, 0xa9, 0x55, 0x46, 0x00, 0x8a, 0x15, 0x24, 0x94, 0xab,
+	0x8c, 0x90, 0x50, 0xf0, 0x3a, 0xa3, 0x67, 0x28, 0xc9, 0x90, 0x9b, 0xc6, 0xf8, 0xa0, 0x0f, 0x4d,
+	0x44, 0x4a, 0x7b, 0xa1, 0xd4, 0xa2, 0x4a, 0x4d, 0x44, 0x4a, 0x1f, 0x4a, 0x8d, 0x84, 0x3f, 0x40,
+	0x62, 0x26, 0x23, 0xfc, 0x01, 0x10, 0x4b, 0x6c, 0x4a, 0xf6, 0x11, 0x9f, 0xa5, 0xee, 0x64, 0x1f,
+	0xe0, 0x2b, 0x6c, 0x52, 0xc6, 0xb1, 0x89, 0x45, 0x8d, 0x16, 0x8f, 0x01, 0xae, 0xd1, 0x7a, 0x49,
+	0xa2, 0x02, 0x31, 0x87, 0x3b, 0x3d, 0xa5, 0xec, 0xdb, 0x44, 0x05, 0xd0, 0x92, 0xb2, 0x9e, 0x8c,
+	0x7c, 0x15, 0x8a, 0x79, 0x64, 0xa6, 0x95, 0x3d, 0x80, 0x90, 0x6f, 0xb2, 0x39, 0x65, 0x3d, 0x65,
+	0xcd, 0xc3, 0x07, 0x77, 0xee, 0x7a, 0x81, 0xef, 0xa4, 0x58, 0x40, 0xc5, 0xac, 0xb2, 0x4d, 0x42,
+	0xf7, 0x7d, 0x27, 0x39, 0x67, 0x13, 0x2e, 0x56, 0x91, 0xe0, 0x48, 0xe2, 0x33, 0xaf, 0xb1, 0xb1,
+	0xb0, 0x2d, 0x16, 0x11, 0x19, 0x0b, 0xdb, 0x10, 0x27, 0x6d, 0x71, 0x85, 0xe2, 0xa4, 0xcd, 0x6f,
+	0xb1, 0x9a, 0x8b, 0x7d, 0x6d, 0x3b, 0x26, 0x8e, 0xbc, 0x4e, 0xa2, 0xdb, 0x62, 0x09, 0xdb, 0x9d,
+	0x2d, 0xd0, 0xe7, 0x89, 0x6e, 0x43, 0x0b, 0x81, 0xf1, 0xc0, 0x2c, 0x99, 0xe9, 0xa4, 0xb8, 0x4a,
+	0x2d, 0x04, 0xe6, 0x95, 0x71, 0x99, 0xa7, 0x24, 0xbf, 0x07, 0x9b, 0xd4, 0xf3, 0xce, 0x64, 0x2a,
+	0xae, 0xd5, 0x4b, 0x8d, 0xea, 0xee, 0xca, 0xf6, 0x88, 0x6f, 0x3f, 0xf1, 0x1f, 0xec, 0x60, 0xef,
+	0xa5, 0x4c, 0xf9, 0x43, 0x56, 0x81, 0xac, 0x0f, 0x7e, 0x98, 0x48, 0x21, 0x2e, 0xcf, 0x2b, 0x47,
+	0x7e, 0xef, 0x1d, 0x88, 0xf9, 0x06, 0x9b, 0x85, 0x4c, 0xf8, 0x62, 0x3d, 0x5f, 0xc5, 0x56, 0x5c,
+	0xc7, 0x4f, 0x50, 0x8d, 0xfc, 0xde, 0x91, 0xd2, 0xaf, 0x01, 0x2a, 0x34, 0xfe, 0x20, 0xd3, 0x2c,
+	0x0f, 0x35, 0xfe, 0x80, 0x34, 0xeb, 0x6c, 0x26, 0x90, 0x1d, 0xa5, 0x65, 0xe0, 0x19, 0x1d, 0xa6,
+	0x62, 0x05, 0x17, 0x57, 0xcd, 0xb0, 0x63, 0x1d, 0xa6, 0xf0, 0xe5, 0xa4, 0x4e, 0x22, 0x4f, 0x69,
+	0x71, 0xa3, 0x3e, 0xde, 0xa8, 0xb4, 0xa6, 0x20, 0x6c, 0x6a, 0xbe, 0xc6, 0xaa, 0x48, 0xc0, 0xee,
+	0x28, 0x2d, 0x56, 0x91, 0xac, 0x00, 0xf4, 0xca, 0xb8, 0xa6, 0x86, 0xf7, 0x9f, 0xa4, 0x4e, 0x5a,
+	0x2f, 0xf7, 0xd5, 0x1a, 0xbd, 0x1f, 0xc1, 0x23, 0x32, 0xd7, 0x50, 0x93, 0x59, 0xec, 0xe6, 0xa8,
+	0x86, 0x7c, 0x56, 0x67, 0x33, 0xa4, 0xc9, 0xdc, 0x56, 0x47, 0x09, 0x43, 0xec, 0x00, 0x2d, 0xb7,
+	0x9e, 0x2b, 0x7a, 0xb1, 0xec, 0xa8, 0x81, 0x58, 0xaf, 0x97, 0x1a, 0x33, 0x59, 0x91, 0xd7, 0x08,
+	0x0d, 0x25, 0x36, 0xe9, 0x80, 0x64, 0x63, 0x44, 0xf2, 0x06, 0x21, 0xb0, 0x04, 0x49, 0x8a, 0x73,
+	0xfd, 0x2f, 0x14, 0x51, 0x87, 0x7b, 0x19, 0xc8, 0x6f, 0x32, 0xca, 0xf2, 0xe8, 0x88, 0xff, 0x1b,
+	0x6d, 0x43, 0xdd, 0xb4, 0x00, 0x81, 0x13, 0x08, 0x2b, 0x56, 0x4e, 0x46, 0x56, 0xdc, 0xc2, 0x66,
+	0xcb, 0x91, 0xd2, 0x4d, 0x88, 0x91, 0xf4, 0x07, 0x19, 0xb9, 0x99, 0x91, 0xfe, 0x80, 0xc8, 0xab,
+	0x6c, 0x2a, 0xd1, 0xaa, 0x9f, 0x48, 0xf1, 0x1f, 0x3a, 0x23, 0x14, 0x41, 0x12, 0x3d, 0x79, 0x27,
+	0xa9, 0x68, 0xd0, 0xb0, 0x21, 0xe0, 0x59, 0x0a, 0x83, 0x28, 0x96, 0xfd, 0x44, 0xc5, 0x32, 0x10,
+	0xff, 0xc5, 0xb4, 0x22, 0xe6, 0x8b, 0x6c, 0xd2, 0x59, 0x2f, 0x74, 0xe2, 0x7f, 0x98, 0x34, 0xe1,
+	0xec, 0xa1, 0xcb, 0xc0, 0xae, 0x13, 0xb7, 0x73, 0xf0, 0x85, 0xe3, 0xcb, 0xac, 0x82, 0x4a, 0x4f,
+	0x9b, 0x73, 0xf1, 0x7f, 0x3a, 0x87, 0xa0, 0x7e, 0x65, 0xce, 0x33, 0xae, 0x4b, 0xdc, 0x56, 0xce,
+	0xbd, 0x40, 0x6e, 0x05, 0xb9, 0x73, 0xe5, 0x4e, 0x95, 0x16, 0xdb, 0xd4, 0x9a, 0xb3, 0xdf, 0x63,
+	0x8c, 0xee, 0x4a, 0x62, 0xf4, 0x2e, 0xce, 0xaa, 0x1d, 0xe4, 0xab, 0x39, 0x06, 0xe3, 0x6a, 0x54,
+	0x02, 0x13, 0xeb, 0xce, 0x45, 0x09, 0x0c, 0xad, 0x55, 0xc6, 0x4e, 0x8c, 0x09, 0xe1, 0xb3, 0x58,
+	0x27, 0xee, 0xe2, 0xfb, 0x2b, 0x80, 0xec, 0x01, 0x00, 0x63, 0xc8, 0xd7, 0x29, 0x38, 0x70, 0x17,
+	0x1d, 0x38, 0xe9, 0xeb, 0xb4, 0xa9, 0xf9, 0x0d, 0xc6, 0x00, 0xce, 0xcc, 0xf9, 0x05, 0x52, 0x65,
+	0x5f, 0xa7, 0xe4, 0xcd, 0x75, 0x36, 0x03, 0x6c, 0x71, 0xa8, 0xef, 0x91, 0xef, 0x7d, 0x9d, 0x16,
+	0x47, 0x7a, 0x93, 0xcd, 0x59, 0x17, 0x27, 0x6d, 0x87, 0xde, 0x3c, 0x93, 0xa9, 0x15, 0xf7, 0xf1,
+	0x7b, 0xcd, 0x12, 0x7c, 0xe4, 0x0f, 0x5e, 0xca, 0xd4, 0xc2, 0xcf, 0x24, 0xd3, 0x9d, 0xc9, 0x34,
+	0x33, 0xc5, 0x03, 0x5c, 0x45, 0x8d, 0xf0, 0x97, 0x32, 0x25, 0x63, 0x0c, 0x95, 0x50, 0x31, 0x90,
+	0x3d, 0x77, 0x2a, 0xbe, 0xc4, 0x92, 0xb5, 0xa2, 0xe4, 0x3e, 0xa0, 0x7c, 0x8b, 0x2d, 0x8e, 0x28,
+	0x43, 0x65, 0x1d, 0x3a, 0xff, 0x21, 0x8a, 0xe7, 0x0b, 0xf1, 0xa1, 0xb2, 0x2e, 0xf3, 0x7f, 0xde,
+	0x82, 0xd2, 0x81, 0x15, 0x5f, 0xe1, 0x6a, 0xab, 0xd9, 0xeb, 0x01, 0x82, 0x83, 0xd6, 0x81, 0x69,
+	0xe2, 0x45, 0xbe, 0x3d, 0xf3, 0x4c, 0x47, 0x3c, 0xa2, 0x8d, 0x46, 0xf0, 0xc8, 0xb7, 0x67, 0xc7,
+	0x1d, 0xbe, 0xcd, 0x16, 0x47, 0x34, 0x81, 0xb2, 0x7e, 0x18, 0x9a, 0x73, 0xf1, 0x18, 0xab, 0x2d,
+	0x14, 0xca, 0xfd, 0x8c, 0xe0, 0xb7, 0xd9, 0x78, 0x5b, 0x86, 0xe2, 0x49, 0x7d, 0xbc, 0x51, 0xdd,
+	0xbd, 0x3e, 0x3a, 0xb8, 0xf6, 0x64, 0x38, 0x32, 0xb6, 0x40, 0x05, 0x73, 0x5e, 0xf6, 0x3d, 0x2c,
+	0x22, 0x9e, 0xd2, 0xaf, 0x54, 0xf6, 0x71, 0xc0, 0x01, 0xa5, 0x65, 0x46, 0x7d, 0x4d, 0x94, 0x96,
+	0x05, 0xd5, 0x75, 0x19, 0xf5, 0x0d, 0x51, 0x5d, 0x47, 0xd4, 0x0a, 0xab, 0x74, 0x5d, 0x9e, 0xf6,
+	0x2d, 0x39, 0xaf, 0xeb, 0x86, 0x79, 0x61, 0x9e, 0xf7, 0x1d, 0xe5, 0x85, 0xc3, 0xbc, 0xb0, 0xc8,
+	0x7b, 0x46, 0x79, 0x61, 0x9e, 0xf7, 0x98, 0x55, 0xf3, 0xc3, 0xe3, 0xa9, 0x8e, 0xd8, 0xc3, 0xa5,
+	0x2d, 0x7f, 0x36, 0x93, 0xf7, 0x8c, 0x0e, 0x14, 0xae, 0x8d, 0xe5, 0xf2, 0x66, 0x87, 0xef, 0xb1,
+	0xb9, 0x22, 0x39, 0xd1, 0xa1, 0xb4, 0x56, 0xec, 0x5f, 0x5a, 0xa0, 0x96, 0xa7, 0xbc, 0xc5, 0x0c,
+	0xfe, 0x94, 0xcd, 0x74, 0x4c, 0x7c, 0xa2, 0x82, 0x40, 0x6a, 0x68, 0xe1, 0xe0, 0xd2, 0x0a, 0xd5,
+	0x42, 0xdf, 0xec, 0x6c, 0xfc, 0x54, 0x62, 0x0b, 0x47, 0xd2, 0x5a, 0xbf, 0x2b, 0x47, 0x2e, 0x3c,
+	0x9b, 0xd0, 0x99, 0x4b, 0x62, 0xed, 0x19, 0xed, 0xd1, 0xdf, 0x99, 0xee, 0x3b, 0xb3, 0x04, 0x1f,
+	0xeb, 0x03, 0x00, 0xc1, 0x48, 0xf0, 0xf7, 0xcc, 0xee, 0x44, 0x74, 0xe7, 0x29, 0xb7, 0xaa, 0x80,
+	0xd1, 0x85, 0xc8, 0xf2, 0x5d, 0xb6, 0xa4, 0x4d, 0x1c, 0xf9, 0xa1, 0xfa, 0x51, 0x7a, 0x4a, 0x0f,
+	0x8f, 0xd0, 0x38, 0x6a, 0x17, 0x0b, 0xb2, 0xa9, 0x8b, 0xa3, 0x94, 0x19, 0x65, 0xe2, 0x9f, 0x18,
+	0x65, 0xe3, 0x07, 0x36, 0x7b, 0x01, 0xe5, 0x6b, 0x8c, 0xc9, 0x41, 0x2f, 0x96, 0xd6, 0x2a, 0xa3,
+	0xb1, 0xef, 0x4a, 0x6b, 0x04, 0xc1, 0x3b, 0x1a, 0xad, 0xb8, 0xb8, 0xa3, 0x51, 0x08, 0x3f, 0x7d,
+	0x15, 0x64, 0xb7, 0xb3, 0x31, 0x15, 0x6c, 0x3c, 0x61, 0xb5, 0x8b, 0x7b, 0x07, 0x97, 0x15, 0x32,
+	0x02, 0x95, 0xa5, 0x00, 0x50, 0xfa, 0x27, 0x53, 0x3d, 0x0a, 0x36, 0xb6, 0xd8, 0xdc, 0xb1, 0x96,
+	0xa6, 0x33, 0xd2, 0xda, 0xe8, 0xec, 0x2d, 0x5d, 0x9c, 0xbd, 0x8f, 0x5a, 0x59, 0x69, 0xbe, 0xba,
+	0x4d, 0x97, 0xd4, 0xed, 0xfc, 0x92, 0x4a, 0x1f, 0xf0, 0xb8, 0x07, 0x25, 0xac, 0xf8, 0xfd, 0xd7,
+	0xf1, 0xcb, 0xff, 0xfc, 0x54, 0xea, 0xd1, 0xfb, 0x62, 0xa9, 0xfc, 0xe6, 0x67, 0x55, 0xb3, 0xcf,
+	0xfe, 0x69, 0xdd, 0xd5, 0xd1, 0xba, 0x9f, 0x59, 0xa3, 0xd8, 0x2c, 0xe8, 0xd7, 0xc0, 0xf2, 0xfe,
+	0xa2, 0x5f, 0x5c, 0xf6, 0xdf, 0xf6, 0xfb, 0xc9, 0xc6, 0xb4, 0xa8, 0xd4, 0xb3, 0xbd, 0x9f, 0x3f,
+	0xae, 0x95, 0x7e, 0xf9, 0xb8, 0x56, 0xfa, 0xed, 0xe3, 0x5a, 0xe9, 0xfd, 0xfd, 0xae, 0x72, 0xa7,
+	0xc9, 0xc9, 0x76, 0xdb, 0x44, 0x3b, 0x5a, 0x9a, 0xde, 0xa9, 0xd4, 0x6a, 0x40, 0x57, 0xf7, 0xf6,
+	0x56, 0x57, 0xea, 0xad, 0x61, 0xc1, 0xc7, 0xc3, 0xc7, 0x3f, 0x07, 0x00, 0x6f, 0x9b, 0x10, 0x47,
+	0x02, 0x0c, 0x00, 0x00,
+}
+
+func (m *FieldValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -417,144 +1115,618 @@ func (m *FieldValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DoNotValidate != nil {
+	if len(m.ForbiddenIf) > 0 {
+		for iNdEx := len(m.ForbiddenIf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForbiddenIf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RequiredUnless) > 0 {
+		for iNdEx := len(m.RequiredUnless) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredUnless[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RequiredIf) > 0 {
+		for iNdEx := len(m.RequiredIf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredIf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.LteField != nil {
+		i -= len(*m.LteField)
+		copy(dAtA[i:], *m.LteField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.LteField)))
 		i--
-		if *m.DoNotValidate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.LtField != nil {
+		i -= len(*m.LtField)
+		copy(dAtA[i:], *m.LtField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.LtField)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.GteField != nil {
+		i -= len(*m.GteField)
+		copy(dAtA[i:], *m.GteField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.GteField)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.GtField != nil {
+		i -= len(*m.GtField)
+		copy(dAtA[i:], *m.GtField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.GtField)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.NeField != nil {
+		i -= len(*m.NeField)
+		copy(dAtA[i:], *m.NeField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.NeField)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.EqField != nil {
+		i -= len(*m.EqField)
+		copy(dAtA[i:], *m.EqField)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.EqField)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.Cel) > 0 {
+		for iNdEx := len(m.Cel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.FieldMaskDisallow) > 0 {
+		for iNdEx := len(m.FieldMaskDisallow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldMaskDisallow[iNdEx])
+			copy(dAtA[i:], m.FieldMaskDisallow[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.FieldMaskDisallow[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
 		}
+	}
+	if m.FieldMaskOf != nil {
+		i -= len(*m.FieldMaskOf)
+		copy(dAtA[i:], *m.FieldMaskOf)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.FieldMaskOf)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0xb0
+		dAtA[i] = 0xd2
 	}
-	if m.TransformFunc != nil {
-		i -= len(*m.TransformFunc)
-		copy(dAtA[i:], *m.TransformFunc)
-		i = encodeVarintValidation(dAtA, i, uint64(len(*m.TransformFunc)))
+	if len(m.StructKinds) > 0 {
+		for iNdEx := len(m.StructKinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StructKinds[iNdEx])
+			copy(dAtA[i:], m.StructKinds[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.StructKinds[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.StructMaxListLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.StructMaxListLen))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xc0
 	}
-	if m.Uc != nil {
+	if m.StructMaxDepth != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.StructMaxDepth))
 		i--
-		if *m.Uc {
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.StructKeyRegex != nil {
+		i -= len(*m.StructKeyRegex)
+		copy(dAtA[i:], *m.StructKeyRegex)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.StructKeyRegex)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.StructMaxKeys != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.StructMaxKeys))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.AnyValidate != nil {
+		i--
+		if *m.AnyValidate {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x3
 		i--
 		dAtA[i] = 0xa0
 	}
-	if m.Lc != nil {
+	if len(m.AnyNotIn) > 0 {
+		for iNdEx := len(m.AnyNotIn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnyNotIn[iNdEx])
+			copy(dAtA[i:], m.AnyNotIn[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.AnyNotIn[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AnyIn) > 0 {
+		for iNdEx := len(m.AnyIn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnyIn[iNdEx])
+			copy(dAtA[i:], m.AnyIn[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.AnyIn[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.BoolConst != nil {
 		i--
-		if *m.Lc {
+		if *m.BoolConst {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x88
 	}
-	if m.Trim != nil {
+	if m.DurationGte != nil {
+		i -= len(*m.DurationGte)
+		copy(dAtA[i:], *m.DurationGte)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.DurationGte)))
 		i--
-		if *m.Trim {
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DurationLte != nil {
+		i -= len(*m.DurationLte)
+		copy(dAtA[i:], *m.DurationLte)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.DurationLte)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.TsWithin != nil {
+		i -= len(*m.TsWithin)
+		copy(dAtA[i:], *m.TsWithin)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.TsWithin)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.TsGtNow != nil {
+		i--
+		if *m.TsGtNow {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xe8
 	}
-	if m.IsIso8601Date != nil {
+	if m.TsLtNow != nil {
 		i--
-		if *m.IsIso8601Date {
+		if *m.TsLtNow {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xe0
 	}
-	if m.IsEmail != nil {
+	if m.TsGt != nil {
+		i -= len(*m.TsGt)
+		copy(dAtA[i:], *m.TsGt)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.TsGt)))
 		i--
-		if *m.IsEmail {
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.TsLt != nil {
+		i -= len(*m.TsLt)
+		copy(dAtA[i:], *m.TsLt)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.TsLt)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Required != nil {
+		i--
+		if *m.Required {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xc8
 	}
-	if m.IsUuid != nil {
+	if m.UniqueBy != nil {
+		i -= len(*m.UniqueBy)
+		copy(dAtA[i:], *m.UniqueBy)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.UniqueBy)))
 		i--
-		if *m.IsUuid {
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Unique != nil {
+		i--
+		if *m.Unique {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.Error != nil {
-		i -= len(*m.Error)
-		copy(dAtA[i:], *m.Error)
-		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Error)))
+	if m.MaxItems != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MaxItems))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.EqLen != nil {
-		i = encodeVarintValidation(dAtA, i, uint64(*m.EqLen))
+	if m.MinItems != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MinItems))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.MaxLen != nil {
-		i = encodeVarintValidation(dAtA, i, uint64(*m.MaxLen))
+	if m.BytesRegex != nil {
+		i -= len(*m.BytesRegex)
+		copy(dAtA[i:], *m.BytesRegex)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.BytesRegex)))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.MinLen != nil {
-		i = encodeVarintValidation(dAtA, i, uint64(*m.MinLen))
+	if m.BytesContains != nil {
+		i -= len(m.BytesContains)
+		copy(dAtA[i:], m.BytesContains)
+		i = encodeVarintValidation(dAtA, i, uint64(len(m.BytesContains)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.FloatEq != nil {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatEq))))
+	if m.BytesSuffix != nil {
+		i -= len(m.BytesSuffix)
+		copy(dAtA[i:], m.BytesSuffix)
+		i = encodeVarintValidation(dAtA, i, uint64(len(m.BytesSuffix)))
 		i--
-		dAtA[i] = 0x51
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.FloatGte != nil {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatGte))))
+	if m.BytesPrefix != nil {
+		i -= len(m.BytesPrefix)
+		copy(dAtA[i:], m.BytesPrefix)
+		i = encodeVarintValidation(dAtA, i, uint64(len(m.BytesPrefix)))
 		i--
-		dAtA[i] = 0x49
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.FloatLte != nil {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatLte))))
+	if m.BytesEqLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.BytesEqLen))
 		i--
-		dAtA[i] = 0x41
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.IntEq != nil {
-		i = encodeVarintValidation(dAtA, i, uint64(*m.IntEq))
+	if m.BytesMaxLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.BytesMaxLen))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if m.IntGte != nil {
-		i = encodeVarintValidation(dAtA, i, uint64(*m.IntGte))
+	if m.BytesMinLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.BytesMinLen))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.EnumNotIn) > 0 {
+		for iNdEx := len(m.EnumNotIn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnumNotIn[iNdEx])
+			copy(dAtA[i:], m.EnumNotIn[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.EnumNotIn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.EnumIn) > 0 {
+		for iNdEx := len(m.EnumIn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnumIn[iNdEx])
+			copy(dAtA[i:], m.EnumIn[iNdEx])
+			i = encodeVarintValidation(dAtA, i, uint64(len(m.EnumIn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.DefinedOnly != nil {
+		i--
+		if *m.DefinedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MapMaxPairs != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MapMaxPairs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MapMinPairs != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MapMinPairs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MapValue != nil {
+		{
+			size, err := m.MapValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.MapKey != nil {
+		{
+			size, err := m.MapKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.DoNotValidate != nil {
+		i--
+		if *m.DoNotValidate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.TransformFunc != nil {
+		i -= len(*m.TransformFunc)
+		copy(dAtA[i:], *m.TransformFunc)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.TransformFunc)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Uc != nil {
+		i--
+		if *m.Uc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Lc != nil {
+		i--
+		if *m.Lc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Trim != nil {
+		i--
+		if *m.Trim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsIso8601Date != nil {
+		i--
+		if *m.IsIso8601Date {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.IsEmail != nil {
+		i--
+		if *m.IsEmail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IsUuid != nil {
+		i--
+		if *m.IsUuid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.EqLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.EqLen))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MaxLen))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinLen != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.MinLen))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.FloatEq != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatEq))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.FloatGte != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatGte))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.FloatLte != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FloatLte))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.IntEq != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.IntEq))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IntGte != nil {
+		i = encodeVarintValidation(dAtA, i, uint64(*m.IntGte))
 		i--
 		dAtA[i] = 0x30
 	}
@@ -621,6 +1793,30 @@ func (m *MessageValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Cel) > 0 {
+		for iNdEx := len(m.Cel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NormalizeInValidate != nil {
+		i--
+		if *m.NormalizeInValidate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.TrimStrings != nil {
 		i--
 		if *m.TrimStrings {
@@ -644,54 +1840,180 @@ func (m *MessageValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintValidation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovValidation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CelValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *FieldValidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CelValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CelValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NotEmptyString != nil {
-		n += 2
-	}
-	if m.Matches != nil {
-		l = len(*m.Matches)
-		n += 1 + l + sovValidation(uint64(l))
-	}
-	if m.Contains != nil {
-		l = len(*m.Contains)
-		n += 1 + l + sovValidation(uint64(l))
-	}
-	if m.Regex != nil {
-		l = len(*m.Regex)
-		n += 1 + l + sovValidation(uint64(l))
-	}
-	if m.IntLte != nil {
-		n += 1 + sovValidation(uint64(*m.IntLte))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IntGte != nil {
-		n += 1 + sovValidation(uint64(*m.IntGte))
+	if m.Id != nil {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IntEq != nil {
-		n += 1 + sovValidation(uint64(*m.IntEq))
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FloatLte != nil {
-		n += 9
+	if m.Expression != nil {
+		i -= len(*m.Expression)
+		copy(dAtA[i:], *m.Expression)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Expression)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.FloatGte != nil {
-		n += 9
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FloatEq != nil {
+	return dAtA[:n], nil
+}
+
+func (m *FieldCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != nil {
+		i -= len(*m.Value)
+		copy(dAtA[i:], *m.Value)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Field != nil {
+		i -= len(*m.Field)
+		copy(dAtA[i:], *m.Field)
+		i = encodeVarintValidation(dAtA, i, uint64(len(*m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OneofValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OneofValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OneofValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Required != nil {
+		i--
+		if *m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FieldValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NotEmptyString != nil {
+		n += 2
+	}
+	if m.Matches != nil {
+		l = len(*m.Matches)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.Contains != nil {
+		l = len(*m.Contains)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.Regex != nil {
+		l = len(*m.Regex)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.IntLte != nil {
+		n += 1 + sovValidation(uint64(*m.IntLte))
+	}
+	if m.IntGte != nil {
+		n += 1 + sovValidation(uint64(*m.IntGte))
+	}
+	if m.IntEq != nil {
+		n += 1 + sovValidation(uint64(*m.IntEq))
+	}
+	if m.FloatLte != nil {
+		n += 9
+	}
+	if m.FloatGte != nil {
+		n += 9
+	}
+	if m.FloatEq != nil {
 		n += 9
 	}
 	if m.MinLen != nil {
@@ -732,6 +2054,197 @@ func (m *FieldValidation) Size() (n int) {
 	if m.DoNotValidate != nil {
 		n += 3
 	}
+	if m.MapKey != nil {
+		l = m.MapKey.Size()
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.MapValue != nil {
+		l = m.MapValue.Size()
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.MapMinPairs != nil {
+		n += 2 + sovValidation(uint64(*m.MapMinPairs))
+	}
+	if m.MapMaxPairs != nil {
+		n += 2 + sovValidation(uint64(*m.MapMaxPairs))
+	}
+	if m.DefinedOnly != nil {
+		n += 3
+	}
+	if len(m.EnumIn) > 0 {
+		for _, s := range m.EnumIn {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if len(m.EnumNotIn) > 0 {
+		for _, s := range m.EnumNotIn {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if m.BytesMinLen != nil {
+		n += 2 + sovValidation(uint64(*m.BytesMinLen))
+	}
+	if m.BytesMaxLen != nil {
+		n += 2 + sovValidation(uint64(*m.BytesMaxLen))
+	}
+	if m.BytesEqLen != nil {
+		n += 2 + sovValidation(uint64(*m.BytesEqLen))
+	}
+	if m.BytesPrefix != nil {
+		l = len(m.BytesPrefix)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.BytesSuffix != nil {
+		l = len(m.BytesSuffix)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.BytesContains != nil {
+		l = len(m.BytesContains)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.BytesRegex != nil {
+		l = len(*m.BytesRegex)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.MinItems != nil {
+		n += 2 + sovValidation(uint64(*m.MinItems))
+	}
+	if m.MaxItems != nil {
+		n += 2 + sovValidation(uint64(*m.MaxItems))
+	}
+	if m.Unique != nil {
+		n += 3
+	}
+	if m.UniqueBy != nil {
+		l = len(*m.UniqueBy)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.Required != nil {
+		n += 3
+	}
+	if m.TsLt != nil {
+		l = len(*m.TsLt)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.TsGt != nil {
+		l = len(*m.TsGt)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.TsLtNow != nil {
+		n += 3
+	}
+	if m.TsGtNow != nil {
+		n += 3
+	}
+	if m.TsWithin != nil {
+		l = len(*m.TsWithin)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.DurationLte != nil {
+		l = len(*m.DurationLte)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.DurationGte != nil {
+		l = len(*m.DurationGte)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.BoolConst != nil {
+		n += 3
+	}
+	if len(m.AnyIn) > 0 {
+		for _, s := range m.AnyIn {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if len(m.AnyNotIn) > 0 {
+		for _, s := range m.AnyNotIn {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if m.AnyValidate != nil {
+		n += 3
+	}
+	if m.StructMaxKeys != nil {
+		n += 2 + sovValidation(uint64(*m.StructMaxKeys))
+	}
+	if m.StructKeyRegex != nil {
+		l = len(*m.StructKeyRegex)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.StructMaxDepth != nil {
+		n += 2 + sovValidation(uint64(*m.StructMaxDepth))
+	}
+	if m.StructMaxListLen != nil {
+		n += 2 + sovValidation(uint64(*m.StructMaxListLen))
+	}
+	if len(m.StructKinds) > 0 {
+		for _, s := range m.StructKinds {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if m.FieldMaskOf != nil {
+		l = len(*m.FieldMaskOf)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if len(m.FieldMaskDisallow) > 0 {
+		for _, s := range m.FieldMaskDisallow {
+			l = len(s)
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if len(m.Cel) > 0 {
+		for _, e := range m.Cel {
+			l = e.Size()
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if m.EqField != nil {
+		l = len(*m.EqField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.NeField != nil {
+		l = len(*m.NeField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.GtField != nil {
+		l = len(*m.GtField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.GteField != nil {
+		l = len(*m.GteField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.LtField != nil {
+		l = len(*m.LtField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if m.LteField != nil {
+		l = len(*m.LteField)
+		n += 2 + l + sovValidation(uint64(l))
+	}
+	if len(m.RequiredIf) > 0 {
+		for _, e := range m.RequiredIf {
+			l = e.Size()
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if len(m.RequiredUnless) > 0 {
+		for _, e := range m.RequiredUnless {
+			l = e.Size()
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
+	if len(m.ForbiddenIf) > 0 {
+		for _, e := range m.ForbiddenIf {
+			l = e.Size()
+			n += 2 + l + sovValidation(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -750,6 +2263,74 @@ func (m *MessageValidation) Size() (n int) {
 	if m.TrimStrings != nil {
 		n += 2
 	}
+	if m.NormalizeInValidate != nil {
+		n += 2
+	}
+	if len(m.Cel) > 0 {
+		for _, e := range m.Cel {
+			l = e.Size()
+			n += 1 + l + sovValidation(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CelValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expression != nil {
+		l = len(*m.Expression)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FieldCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Field != nil {
+		l = len(*m.Field)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovValidation(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OneofValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Required != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -805,16 +2386,1561 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.NotEmptyString = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Matches = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Contains = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Regex = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntLte", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntLte = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntGte", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntGte = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntEq", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntEq = &v
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatLte", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.FloatLte = &v2
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatGte", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.FloatGte = &v2
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatEq", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.FloatEq = &v2
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinLen = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxLen = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EqLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EqLen = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Error = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUuid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IsUuid = &b
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEmail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IsEmail = &b
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsIso8601Date", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IsIso8601Date = &b
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Trim = &b
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Lc = &b
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Uc = &b
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformFunc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TransformFunc = &s
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotValidate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DoNotValidate = &b
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapKey == nil {
+				m.MapKey = &FieldValidation{}
+			}
+			if err := m.MapKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapValue == nil {
+				m.MapValue = &FieldValidation{}
+			}
+			if err := m.MapValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapMinPairs", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MapMinPairs = &v
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapMaxPairs", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MapMaxPairs = &v
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefinedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DefinedOnly = &b
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnumIn = append(m.EnumIn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumNotIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnumNotIn = append(m.EnumNotIn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMinLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BytesMinLen = &v
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMaxLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BytesMaxLen = &v
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesEqLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BytesEqLen = &v
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesPrefix = append(m.BytesPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesPrefix == nil {
+				m.BytesPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSuffix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesSuffix = append(m.BytesSuffix[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesSuffix == nil {
+				m.BytesSuffix = []byte{}
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesContains", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesContains = append(m.BytesContains[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesContains == nil {
+				m.BytesContains = []byte{}
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BytesRegex = &s
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinItems", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinItems = &v
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItems", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxItems = &v
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Unique = &b
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.UniqueBy = &s
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Required = &b
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsLt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TsLt = &s
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsGt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TsGt = &s
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsLtNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TsLtNow = &b
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsGtNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TsGtNow = &b
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsWithin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TsWithin = &s
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationLte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DurationLte = &s
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationGte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DurationGte = &s
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolConst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.BoolConst = &b
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyIn = append(m.AnyIn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyNotIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyNotIn = append(m.AnyNotIn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyValidate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AnyValidate = &b
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructMaxKeys", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StructMaxKeys = &v
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructKeyRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.StructKeyRegex = &s
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructMaxDepth", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StructMaxDepth = &v
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructMaxListLen", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StructMaxListLen = &v
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructKinds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StructKinds = append(m.StructKinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMaskOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FieldMaskOf = &s
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMaskDisallow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldMaskDisallow = append(m.FieldMaskDisallow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cel = append(m.Cel, &CelValidation{})
+			if err := m.Cel[len(m.Cel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EqField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.NotEmptyString = &b
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.EqField = &s
+			iNdEx = postIndex
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NeField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -843,11 +3969,11 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Matches = &s
+			m.NeField = &s
 			iNdEx = postIndex
-		case 3:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GtField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -876,11 +4002,11 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Contains = &s
+			m.GtField = &s
 			iNdEx = postIndex
-		case 4:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GteField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -909,13 +4035,13 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Regex = &s
+			m.GteField = &s
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntLte", wireType)
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LtField", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -925,17 +4051,30 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntLte = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntGte", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
 			}
-			var v int64
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LtField = &s
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LteField", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -945,17 +4084,30 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntGte = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntEq", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
 			}
-			var v int64
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LteField = &s
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredIf", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -965,53 +4117,31 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntEq = &v
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloatLte", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.FloatLte = &v2
-		case 9:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloatGte", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.FloatGte = &v2
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloatEq", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.RequiredIf = append(m.RequiredIf, &FieldCondition{})
+			if err := m.RequiredIf[len(m.RequiredIf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.FloatEq = &v2
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLen", wireType)
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredUnless", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1021,57 +4151,31 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MinLen = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLen", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowValidation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
 			}
-			m.MaxLen = &v
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EqLen", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowValidation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RequiredUnless = append(m.RequiredUnless, &FieldCondition{})
+			if err := m.RequiredUnless[len(m.RequiredUnless)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.EqLen = &v
-		case 14:
+			iNdEx = postIndex
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForbiddenIf", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1081,49 +4185,83 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthValidation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthValidation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Error = &s
+			m.ForbiddenIf = append(m.ForbiddenIf, &FieldCondition{})
+			if err := m.ForbiddenIf[len(m.ForbiddenIf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsUuid", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowValidation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthValidation
 			}
-			b := bool(v != 0)
-			m.IsUuid = &b
-		case 16:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEmail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnOnError", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1141,10 +4279,10 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.IsEmail = &b
-		case 17:
+			m.ReturnOnError = &b
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsIso8601Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimStrings", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1162,10 +4300,10 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.IsIso8601Date = &b
-		case 18:
+			m.TrimStrings = &b
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeInValidate", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1183,12 +4321,12 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.Trim = &b
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lc", wireType)
+			m.NormalizeInValidate = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cel", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1198,18 +4336,85 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Lc = &b
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uc", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthValidation
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cel = append(m.Cel, &CelValidation{})
+			if err := m.Cel[len(m.Cel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CelValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CelValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CelValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1219,16 +4424,28 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Uc = &b
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Expression = &s
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransformFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1257,13 +4474,13 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.TransformFunc = &s
+			m.Message = &s
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotValidate", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1273,13 +4490,25 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.DoNotValidate = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidation(dAtA[iNdEx:])
@@ -1305,7 +4534,7 @@ func (m *FieldValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageValidation) Unmarshal(dAtA []byte) error {
+func (m *FieldCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,17 +4557,17 @@ func (m *MessageValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnOnError", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidation
@@ -1348,16 +4577,115 @@ func (m *MessageValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ReturnOnError = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Field = &s
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OneofValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OneofValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OneofValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrimStrings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1375,7 +4703,7 @@ func (m *MessageValidation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.TrimStrings = &b
+			m.Required = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidation(dAtA[iNdEx:])
@@ -1404,7 +4732,6 @@ func (m *MessageValidation) Unmarshal(dAtA []byte) error {
 func skipValidation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
-	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1436,8 +4763,10 @@ func skipValidation(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
+			return iNdEx, nil
 		case 1:
 			iNdEx += 8
+			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1458,30 +4787,55 @@ func skipValidation(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthValidation
 			}
 			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthValidation
+			}
+			return iNdEx, nil
 		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupValidation
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowValidation
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipValidation(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthValidation
+				}
 			}
-			depth--
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
 		case 5:
 			iNdEx += 4
+			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthValidation
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
 	}
-	return 0, io.ErrUnexpectedEOF
+	panic("unreachable")
 }
 
 var (
-	ErrInvalidLengthValidation        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowValidation          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupValidation = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidLengthValidation = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidation   = fmt.Errorf("proto: integer overflow")
 )